@@ -1,4 +1,4 @@
-package main
+package canvas
 
 import (
 	"fmt"
@@ -17,7 +17,7 @@ type Canvas struct {
 	Height int64
 }
 
-func canvas(w int64, h int64) Canvas {
+func NewCanvas(w int64, h int64) Canvas {
 	p := make([][]Color, h)
 	for i := range p {
 		p[i] = make([]Color, w)
@@ -29,11 +29,11 @@ func canvas(w int64, h int64) Canvas {
 	return Canvas{p, w, h}
 }
 
-func pixelAt(c Canvas, x int64, y int64) Color {
+func PixelAt(c Canvas, x int64, y int64) Color {
 	return c.Pixels[y][x]
 }
 
-func writePixel(c Canvas, x int64, y int64, color Color) {
+func WritePixel(c Canvas, x int64, y int64, color Color) {
 	if x >= c.Width || x < 0 || y >= c.Height || y < 0 {
 		return
 	}
@@ -51,7 +51,7 @@ func scaleColorDimension(f float64) int64 {
 	return int64(math.Round((COLOR_MAX - COLOR_MIN) * f))
 }
 
-func canvasToPPM(c Canvas) string {
+func CanvasToPPM(c Canvas) string {
 	b := strings.Builder{}
 	b.WriteString("P3\n")
 	b.WriteString(fmt.Sprintf("%d %d\n", c.Width, c.Height))
@@ -1,4 +1,4 @@
-package main
+package canvas
 
 import (
 	"strings"
@@ -6,7 +6,7 @@ import (
 )
 
 func TestCreateCanvas(t *testing.T) {
-	c := canvas(10, 20)
+	c := NewCanvas(10, 20)
 
 	if c.Width != 10 {
 		t.Errorf("Expected width to be 10 but got %d", c.Width)
@@ -18,7 +18,7 @@ func TestCreateCanvas(t *testing.T) {
 
 	for i, v := range c.Pixels {
 		for j, u := range v {
-			if !colorEqual(u, Color{0, 0, 0}) {
+			if !ColorEqual(u, Color{0, 0, 0}) {
 				t.Errorf("Expected pixel at %d,%d to be %v but got %v", j, i, Color{0, 0, 0}, u)
 			}
 		}
@@ -26,18 +26,18 @@ func TestCreateCanvas(t *testing.T) {
 }
 
 func TestWritePixel(t *testing.T) {
-	c := canvas(10, 20)
+	c := NewCanvas(10, 20)
 	red := Color{1, 0, 0}
-	writePixel(c, 2, 3, red)
+	WritePixel(c, 2, 3, red)
 
-	if !colorEqual(pixelAt(c, 2, 3), red) {
+	if !ColorEqual(PixelAt(c, 2, 3), red) {
 		t.Errorf("Expected pixel at %d,%d to be %v but got %v", 2, 3, red, c.Pixels[3][2])
 	}
 }
 
 func TestPPMHeader(t *testing.T) {
-	c := canvas(5, 3)
-	p := canvasToPPM(c)
+	c := NewCanvas(5, 3)
+	p := CanvasToPPM(c)
 	lines := strings.Split(p, "\n")
 
 	if lines[0] != "P3" {
@@ -52,16 +52,16 @@ func TestPPMHeader(t *testing.T) {
 }
 
 func TestPPMData(t *testing.T) {
-	c := canvas(5, 3)
+	c := NewCanvas(5, 3)
 	c1 := Color{1.5, 0, 0}
 	c2 := Color{0, 0.5, 0}
 	c3 := Color{-0.5, 0, 1}
 
-	writePixel(c, 0, 0, c1)
-	writePixel(c, 2, 1, c2)
-	writePixel(c, 4, 2, c3)
+	WritePixel(c, 0, 0, c1)
+	WritePixel(c, 2, 1, c2)
+	WritePixel(c, 4, 2, c3)
 
-	p := canvasToPPM(c)
+	p := CanvasToPPM(c)
 	lines := strings.Split(p, "\n")
 
 	if lines[3] != "255 0 0 0 0 0 0 0 0 0 0 0 0 0 0" {
@@ -76,16 +76,16 @@ func TestPPMData(t *testing.T) {
 }
 
 func Test70MaxLineLength(t *testing.T) {
-	c := canvas(10, 2)
+	c := NewCanvas(10, 2)
 	color := Color{1, 0.8, 0.6}
 
 	for i := range c.Pixels {
 		for j := range c.Pixels[i] {
-			writePixel(c, int64(j), int64(i), color)
+			WritePixel(c, int64(j), int64(i), color)
 		}
 	}
 
-	p := canvasToPPM(c)
+	p := CanvasToPPM(c)
 
 	lines := strings.Split(p, "\n")
 
@@ -104,8 +104,8 @@ func Test70MaxLineLength(t *testing.T) {
 }
 
 func TestTerminateWithNewline(t *testing.T) {
-	c := canvas(5, 3)
-	ppm := canvasToPPM(c)
+	c := NewCanvas(5, 3)
+	ppm := CanvasToPPM(c)
 	if ppm[len(ppm)-1] != '\n' {
 		t.Errorf("Expected ppm to end with \n but got %b", ppm[len(ppm)-1])
 	}
@@ -0,0 +1,33 @@
+package canvas
+
+import "ray-tracer-challenge/rtmath"
+
+type Color struct {
+	Red   float64
+	Green float64
+	Blue  float64
+}
+
+func NewColor(r float64, g float64, b float64) Color {
+	return Color{r, g, b}
+}
+
+func ColorEqual(a Color, b Color) bool {
+	return rtmath.FloatEqual(a.Red, b.Red) && rtmath.FloatEqual(a.Green, b.Green) && rtmath.FloatEqual(a.Blue, b.Blue)
+}
+
+func ColorAdd(a Color, b Color) Color {
+	return Color{a.Red + b.Red, a.Green + b.Green, a.Blue + b.Blue}
+}
+
+func ColorSubtract(a Color, b Color) Color {
+	return Color{a.Red - b.Red, a.Green - b.Green, a.Blue - b.Blue}
+}
+
+func ColorScale(a Color, k float64) Color {
+	return Color{a.Red * k, a.Green * k, a.Blue * k}
+}
+
+func ColorBlend(a Color, b Color) Color {
+	return Color{a.Red * b.Red, a.Green * b.Green, a.Blue * b.Blue}
+}
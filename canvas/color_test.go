@@ -1,17 +1,21 @@
-package main
+package canvas
 
-import "testing"
+import (
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
 
 func TestColorRGB(t *testing.T) {
 	c := Color{-0.5, 0.4, 1.7}
 
-	if !floatEqual(c.Red, -0.5) {
+	if !rtmath.FloatEqual(c.Red, -0.5) {
 		t.Errorf("Expected red to be -0.5 but got %f", c.Red)
 	}
-	if !floatEqual(c.Green, 0.4) {
+	if !rtmath.FloatEqual(c.Green, 0.4) {
 		t.Errorf("Expected green to be 0.4 but got %f", c.Green)
 	}
-	if !floatEqual(c.Blue, 1.7) {
+	if !rtmath.FloatEqual(c.Blue, 1.7) {
 		t.Errorf("Expected blue to be 1.7 but got %f", c.Blue)
 	}
 }
@@ -21,8 +25,8 @@ func TestAddColors(t *testing.T) {
 	c2 := Color{0.7, 0.1, 0.25}
 	expected := Color{1.6, 0.7, 1.0}
 
-	if !colorEqual(colorAdd(c1, c2), expected) {
-		t.Errorf("Expected c1 + c2 to be %v but got %v", expected, colorAdd(c1, c2))
+	if !ColorEqual(ColorAdd(c1, c2), expected) {
+		t.Errorf("Expected c1 + c2 to be %v but got %v", expected, ColorAdd(c1, c2))
 	}
 }
 
@@ -31,16 +35,16 @@ func TestSubtractColors(t *testing.T) {
 	c2 := Color{0.7, 0.1, 0.25}
 	expected := Color{0.2, 0.5, 0.5}
 
-	if !colorEqual(colorSubtract(c1, c2), expected) {
-		t.Errorf("Expected c1 - c2 to be %v but got %v", expected, colorSubtract(c1, c2))
+	if !ColorEqual(ColorSubtract(c1, c2), expected) {
+		t.Errorf("Expected c1 - c2 to be %v but got %v", expected, ColorSubtract(c1, c2))
 	}
 }
 
 func TestScaleColor(t *testing.T) {
 	c := Color{0.2, 0.3, 0.4}
 	expected := Color{0.4, 0.6, 0.8}
-	if !colorEqual(colorScale(c, 2), expected) {
-		t.Errorf("Expected c * 2 to be %v but got %v", expected, colorScale(c, 2))
+	if !ColorEqual(ColorScale(c, 2), expected) {
+		t.Errorf("Expected c * 2 to be %v but got %v", expected, ColorScale(c, 2))
 	}
 }
 
@@ -49,7 +53,7 @@ func TestBlendColors(t *testing.T) {
 	c2 := Color{0.9, 1, 0.1}
 	expected := Color{0.9, 0.2, 0.04}
 
-	if !colorEqual(colorBlend(c1, c2), expected) {
-		t.Errorf("Expected c1 * c2 to be %v but got %v", expected, colorBlend(c1, c2))
+	if !ColorEqual(ColorBlend(c1, c2), expected) {
+		t.Errorf("Expected c1 * c2 to be %v but got %v", expected, ColorBlend(c1, c2))
 	}
 }
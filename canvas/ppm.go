@@ -0,0 +1,129 @@
+package canvas
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+func CanvasFromPPMFile(path string) (Canvas, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Canvas{}, err
+	}
+	defer f.Close()
+
+	return CanvasFromPPM(f)
+}
+
+// Most rows or pixels per row allocated before any of them are read
+const PPM_PREALLOCATE_LIMIT = 4096
+
+// Reads plain (P3) and binary (P6) PPM images, scaling colors to 0..1
+func CanvasFromPPM(r io.Reader) (Canvas, error) {
+	br := bufio.NewReader(r)
+	magic, err := readPPMToken(br)
+	if err != nil {
+		return Canvas{}, err
+	}
+	if magic != "P3" && magic != "P6" {
+		return Canvas{}, fmt.Errorf("expected PPM magic number P3 or P6 but got %q", magic)
+	}
+
+	header := make([]int64, 3)
+	for i, name := range []string{"width", "height", "maximum color value"} {
+		tok, err := readPPMToken(br)
+		if err != nil {
+			return Canvas{}, err
+		}
+		header[i], err = strconv.ParseInt(tok, 10, 64)
+		if err != nil || header[i] <= 0 {
+			return Canvas{}, fmt.Errorf("invalid PPM %s %q", name, tok)
+		}
+	}
+	w, h, max := header[0], header[1], header[2]
+	if max > 65535 {
+		return Canvas{}, fmt.Errorf("PPM maximum color value %d is over 65535", max)
+	}
+
+	readSample := func() (int64, error) {
+		tok, err := readPPMToken(br)
+		if err != nil {
+			return 0, err
+		}
+		return strconv.ParseInt(tok, 10, 64)
+	}
+	if magic == "P6" {
+		// Reading the maximum color value consumed the single whitespace
+		// character before the binary samples
+		readSample = func() (int64, error) {
+			b, err := br.ReadByte()
+			if err != nil {
+				return 0, err
+			}
+			if max < 256 {
+				return int64(b), nil
+			}
+			lo, err := br.ReadByte()
+			if err != nil {
+				return 0, err
+			}
+			return int64(b)<<8 | int64(lo), nil
+		}
+	}
+
+	// Rows are only allocated once their pixels are read, so a header
+	// claiming a huge image can't take more memory than the file backs up
+	pixels := make([][]Color, 0, min(h, PPM_PREALLOCATE_LIMIT))
+	for y := int64(0); y < h; y++ {
+		row := make([]Color, 0, min(w, PPM_PREALLOCATE_LIMIT))
+		for x := int64(0); x < w; x++ {
+			rgb := [3]float64{}
+			for i := range rgb {
+				s, err := readSample()
+				if err != nil {
+					return Canvas{}, fmt.Errorf("reading pixel %d,%d: %w", x, y, err)
+				}
+				if s < 0 || s > max {
+					return Canvas{}, fmt.Errorf("sample %d of pixel %d,%d is outside 0..%d", s, x, y, max)
+				}
+				rgb[i] = float64(s) / float64(max)
+			}
+			row = append(row, Color{rgb[0], rgb[1], rgb[2]})
+		}
+		pixels = append(pixels, row)
+	}
+	return Canvas{pixels, w, h}, nil
+}
+
+// Skips whitespace and comments, which run from # to the end of the line
+func readPPMToken(br *bufio.Reader) (string, error) {
+	tok := []byte{}
+	for {
+		b, err := br.ReadByte()
+		if err == io.EOF && len(tok) > 0 {
+			return string(tok), nil
+		}
+		if err != nil {
+			if err == io.EOF {
+				return "", io.ErrUnexpectedEOF
+			}
+			return "", err
+		}
+
+		switch {
+		case b == '#' && len(tok) == 0:
+			if _, err := br.ReadString('\n'); err != nil && err != io.EOF {
+				return "", err
+			}
+		case b == ' ' || b == '\t' || b == '\n' || b == '\r':
+			if len(tok) > 0 {
+				return string(tok), nil
+			}
+		default:
+			tok = append(tok, b)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package canvas
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCanvasFromPPMRejectsWrongMagicNumber(t *testing.T) {
+	ppm := "P32\n1 1\n255\n0 0 0\n"
+	_, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err == nil {
+		t.Errorf("Expected an error for the wrong magic number")
+	}
+}
+
+func TestCanvasFromPPMReturnsCanvasOfRightSize(t *testing.T) {
+	ppm := `P3
+10 2
+255
+0 0 0  0 0 0  0 0 0  0 0 0  0 0 0
+0 0 0  0 0 0  0 0 0  0 0 0  0 0 0
+0 0 0  0 0 0  0 0 0  0 0 0  0 0 0
+0 0 0  0 0 0  0 0 0  0 0 0  0 0 0
+`
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Width != 10 || c.Height != 2 {
+		t.Errorf("Expected 10x2 canvas but got %dx%d", c.Width, c.Height)
+	}
+}
+
+func TestCanvasFromPPMReadsPixelData(t *testing.T) {
+	ppm := `P3
+4 3
+255
+255 127 0  0 127 255  127 255 0  255 255 255
+0 0 0  255 0 0  0 255 0  0 0 255
+255 255 0  0 255 255  255 0 255  127 127 127
+`
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		x     int64
+		y     int64
+		color Color
+	}
+	cases := []testCase{
+		{0, 0, Color{1, 0.49804, 0}},
+		{1, 0, Color{0, 0.49804, 1}},
+		{2, 0, Color{0.49804, 1, 0}},
+		{3, 0, Color{1, 1, 1}},
+		{0, 1, Color{0, 0, 0}},
+		{1, 1, Color{1, 0, 0}},
+		{2, 1, Color{0, 1, 0}},
+		{3, 1, Color{0, 0, 1}},
+		{0, 2, Color{1, 1, 0}},
+		{1, 2, Color{0, 1, 1}},
+		{2, 2, Color{1, 0, 1}},
+		{3, 2, Color{0.49804, 0.49804, 0.49804}},
+	}
+	for _, tc := range cases {
+		if !ColorEqual(PixelAt(c, tc.x, tc.y), tc.color) {
+			t.Errorf("Expected pixel at %d,%d to be %v but got %v", tc.x, tc.y, tc.color, PixelAt(c, tc.x, tc.y))
+		}
+	}
+}
+
+func TestCanvasFromPPMIgnoresComments(t *testing.T) {
+	ppm := `P3
+# this is a comment
+2 1
+# this, too
+255
+# another comment
+255 255 255
+# oh, no, comments in the pixel data!
+255 0 255
+`
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ColorEqual(PixelAt(c, 0, 0), Color{1, 1, 1}) || !ColorEqual(PixelAt(c, 1, 0), Color{1, 0, 1}) {
+		t.Errorf("Expected white and magenta but got %v", c.Pixels)
+	}
+}
+
+func TestCanvasFromPPMAllowsRGBToSpanLines(t *testing.T) {
+	ppm := `P3
+1 1
+255
+51
+153
+
+204
+`
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ColorEqual(PixelAt(c, 0, 0), Color{0.2, 0.6, 0.8}) {
+		t.Errorf("Expected %v to be %v", PixelAt(c, 0, 0), Color{0.2, 0.6, 0.8})
+	}
+}
+
+func TestCanvasFromPPMRespectsScaleSetting(t *testing.T) {
+	ppm := `P3
+2 2
+100
+100 100 100  50 50 50
+75 50 25  0 0 0
+`
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ColorEqual(PixelAt(c, 0, 1), Color{0.75, 0.5, 0.25}) {
+		t.Errorf("Expected %v to be %v", PixelAt(c, 0, 1), Color{0.75, 0.5, 0.25})
+	}
+}
+
+func TestCanvasFromBinaryPPM(t *testing.T) {
+	ppm := "P6\n# binary\n2 1\n255\n\xff\x00\x80\x00\xff\x00"
+	c, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ColorEqual(PixelAt(c, 0, 0), Color{1, 0, 128. / 255}) || !ColorEqual(PixelAt(c, 1, 0), Color{0, 1, 0}) {
+		t.Errorf("Expected red-purple and green but got %v", c.Pixels)
+	}
+}
+
+func TestCanvasFromPPMRejectsSamplesOutOfRange(t *testing.T) {
+	for _, ppm := range []string{
+		"P3\n1 1\n255\n300 0 10\n",
+		"P3\n1 1\n255\n0 -5 10\n",
+		"P6\n1 1\n100\n\x00\xc8\x00",
+	} {
+		_, err := CanvasFromPPM(strings.NewReader(ppm))
+		if err == nil {
+			t.Errorf("Expected an error for samples out of range in %q", ppm)
+		}
+	}
+}
+
+func TestCanvasFromPPMDoesNotTrustHugeHeader(t *testing.T) {
+	ppm := "P3\n1000000000 1000000000\n255\n0 0 0\n"
+	_, err := CanvasFromPPM(strings.NewReader(ppm))
+	if err == nil {
+		t.Errorf("Expected an error for missing pixel data")
+	}
+}
+
+func TestCanvasToPPMRoundTrips(t *testing.T) {
+	c := NewCanvas(3, 2)
+	WritePixel(c, 0, 0, Color{1, 0, 0})
+	WritePixel(c, 2, 1, Color{0, 0.2, 1})
+	res, err := CanvasFromPPM(strings.NewReader(CanvasToPPM(c)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ColorEqual(PixelAt(res, 0, 0), Color{1, 0, 0}) || !ColorEqual(PixelAt(res, 2, 1), Color{0, 0.2, 1}) {
+		t.Errorf("Expected the canvas to survive a round trip but got %v", res.Pixels)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+	"ray-tracer-challenge/world"
+)
+
+func main() {
+	floor := geometry.NewPlane()
+	floor.Material = shading.NewMaterial()
+	floor.Material.Color = canvas.NewColor(1, 0.9, 0.9)
+	floor.Material.Specular = 0
+	floor.Material.Diffuse = 0.1
+
+	leftWall := geometry.NewPlane()
+
+	lt, err := rtmath.Transformation(
+		rtmath.RotationX(math.Pi/2.),
+		rtmath.RotationY(-math.Pi/4.),
+		rtmath.Translation(0, 0, 5),
+	)
+	if err != nil {
+		os.Exit(-1)
+	}
+	leftWall.Transform = lt
+	leftWall.Material = floor.Material
+
+	rightWall := geometry.NewPlane()
+	rt, err := rtmath.Transformation(
+		rtmath.RotationX(math.Pi/2.),
+		rtmath.RotationY(math.Pi/4.),
+		rtmath.Translation(0, 0, 5),
+	)
+	if err != nil {
+		os.Exit(-1)
+	}
+	rightWall.Transform = rt
+	rightWall.Material = floor.Material
+
+	// Checkers make the perspective and reflections easy to judge
+	floor.Material.Pattern = shading.NewCheckerPattern(canvas.NewColor(1, 0.9, 0.9), canvas.NewColor(0.3, 0.25, 0.25))
+	floor.Material.Reflective = 0.2
+
+	middle := geometry.NewSphere()
+	t, err := rtmath.Transformation(rtmath.Translation(-0.5, 1, 0.5), rtmath.Scaling(1, 1.7, 1))
+	if err != nil {
+		os.Exit(-1)
+	}
+	middle.Transform = t
+	middle.Material = shading.NewMaterial()
+	middle.Material.Color = canvas.NewColor(0.1, 1, 0.5)
+	middle.Material.Diffuse = 0.7
+	middle.Material.Specular = 0.3
+
+	right := geometry.NewSphere()
+	t, err = rtmath.Transformation(rtmath.Scaling(0.5, 0.5, 0.5), rtmath.Translation(1.5, 0.5, -0.5))
+	if err != nil {
+		os.Exit(-1)
+	}
+	right.Transform = t
+	right.Material.Color = canvas.NewColor(0.5, 1, 0.1)
+	right.Material.Diffuse = 0.7
+	right.Material.Specular = 0.3
+
+	left := geometry.NewSphere()
+	t, err = rtmath.Transformation(rtmath.Scaling(0.33, 0.33, 0.33), rtmath.Translation(-1.5, 0.33, -0.75), rtmath.Shearing(-0.3, 0.2, 0, 0, 0.5, 0))
+	if err != nil {
+		os.Exit(-1)
+	}
+	left.Transform = t
+	left.Material.Color = canvas.NewColor(1, 0.8, 0.1)
+	left.Material.Diffuse = 0.7
+	left.Material.Specular = 0.3
+
+	float := geometry.NewSphere()
+	t, err = rtmath.Transformation(rtmath.Translation(2, 2.1, -0.5), rtmath.Shearing(0.5, 0, 0, 0, 0, 0))
+	if err != nil {
+		os.Exit(-1)
+	}
+	float.Transform = t
+	left.Material.Color = canvas.NewColor(0.8, 0.6, 0.6)
+	left.Material.Diffuse = 0.7
+	left.Material.Specular = 0.3
+
+	w := world.NewWorld()
+	w.Objects = []geometry.Shape{leftWall, rightWall, floor, left, right, middle, float}
+	l1, err := shading.NewPointLight(rtmath.Point(-10, 10, -10), canvas.NewColor(1, 0.2, 0.3))
+	if err != nil {
+		os.Exit(-1)
+	}
+	l2, err := shading.NewPointLight(rtmath.Point(10, 10, 10), canvas.NewColor(0.2, 0.8, 1))
+	if err != nil {
+		os.Exit(-1)
+	}
+	l3, err := shading.NewPointLight(rtmath.Point(0, 0, 0), canvas.NewColor(0.5, 0.5, 0.5))
+	if err != nil {
+		os.Exit(-1)
+	}
+	// Fade with distance so the nearby light matters more than the far ones
+	fade, err := shading.NewAttenuation(1, 0.022, 0.0019)
+	if err != nil {
+		os.Exit(-1)
+	}
+	l1.Attenuation = fade
+	l2.Attenuation = fade
+	l3.Attenuation = fade
+	w.Lights = []shading.Light{l1, l2, l3}
+	cam := world.NewCamera(500, 500, math.Pi/3.)
+	vt, err := world.ViewTransform(rtmath.Point(0, 1.5, -5), rtmath.Point(0, 1, 0), rtmath.Vector(0, 1, 0))
+	cam.Transform = vt
+	if err != nil {
+		os.Exit(-1)
+	}
+	opts := world.RenderOptions{
+		Progress: func(p world.RenderProgress) {
+			fmt.Fprintf(os.Stderr, "\r%3d%% done, %v left ", 100*p.DonePixels/p.TotalPixels, p.Remaining.Round(time.Second))
+		},
+		// Extra rays only along edges and other sharp changes
+		Sampling: world.Sampling{Mode: world.SAMPLE_ADAPTIVE, Grid: 4, Filter: world.FILTER_TENT},
+	}
+	image, err := world.RenderContext(context.Background(), cam, w, opts)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		os.Exit(-1)
+	}
+	ppm := canvas.CanvasToPPM(image)
+
+	os.WriteFile("camera.ppm", []byte(ppm), 0644)
+}
@@ -0,0 +1,149 @@
+package geometry
+
+import (
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+// Bounds is an axis-aligned bounding box
+type Bounds struct {
+	Min rtmath.Tuple
+	Max rtmath.Tuple
+}
+
+// Returns empty bounds that any point can be added to
+func NewBounds() Bounds {
+	inf := math.Inf(1)
+	return Bounds{rtmath.Point(inf, inf, inf), rtmath.Point(-inf, -inf, -inf)}
+}
+
+func BoundsAddPoint(b Bounds, p rtmath.Tuple) Bounds {
+	return Bounds{
+		rtmath.Point(math.Min(b.Min.X, p.X), math.Min(b.Min.Y, p.Y), math.Min(b.Min.Z, p.Z)),
+		rtmath.Point(math.Max(b.Max.X, p.X), math.Max(b.Max.Y, p.Y), math.Max(b.Max.Z, p.Z)),
+	}
+}
+
+func BoundsMerge(a Bounds, b Bounds) Bounds {
+	return BoundsAddPoint(BoundsAddPoint(a, b.Min), b.Max)
+}
+
+func BoundsIsFinite(b Bounds) bool {
+	for _, f := range []float64{b.Min.X, b.Min.Y, b.Min.Z, b.Max.X, b.Max.Y, b.Max.Z} {
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return false
+		}
+	}
+	return true
+}
+
+func BoundsContainsPoint(b Bounds, p rtmath.Tuple) bool {
+	return b.Min.X <= p.X && p.X <= b.Max.X &&
+		b.Min.Y <= p.Y && p.Y <= b.Max.Y &&
+		b.Min.Z <= p.Z && p.Z <= b.Max.Z
+}
+
+// Returns the axis-aligned bounds of b after being transformed by m
+func BoundsTransform(b Bounds, m rtmath.Matrix) Bounds {
+	out := NewBounds()
+	xs := [2]float64{b.Min.X, b.Max.X}
+	ys := [2]float64{b.Min.Y, b.Max.Y}
+	zs := [2]float64{b.Min.Z, b.Max.Z}
+
+	for _, x := range xs {
+		for _, y := range ys {
+			for _, z := range zs {
+				c := [4]float64{x, y, z, 1}
+				p := [3]float64{}
+				for i := range p {
+					for j := range c {
+						// Skip zero terms so 0 * Inf doesn't turn into NaN
+						if m.Values[i][j] != 0 {
+							p[i] += m.Values[i][j] * c[j]
+						}
+					}
+				}
+				// Inf - Inf means the bounds are unlimited along that axis
+				unlimited := [3]bool{}
+				for i := range p {
+					if math.IsNaN(p[i]) {
+						unlimited[i] = true
+						p[i] = 0
+					}
+				}
+				if unlimited[0] || unlimited[1] || unlimited[2] {
+					out = BoundsAddPoint(out, rtmath.Point(
+						unlimitedOr(unlimited[0], math.Inf(-1), p[0]),
+						unlimitedOr(unlimited[1], math.Inf(-1), p[1]),
+						unlimitedOr(unlimited[2], math.Inf(-1), p[2])))
+					out = BoundsAddPoint(out, rtmath.Point(
+						unlimitedOr(unlimited[0], math.Inf(1), p[0]),
+						unlimitedOr(unlimited[1], math.Inf(1), p[1]),
+						unlimitedOr(unlimited[2], math.Inf(1), p[2])))
+				}
+				out = BoundsAddPoint(out, rtmath.Point(p[0], p[1], p[2]))
+			}
+		}
+	}
+	return out
+}
+
+func unlimitedOr(unlimited bool, inf float64, f float64) float64 {
+	if unlimited {
+		return inf
+	}
+	return f
+}
+
+// Returns the ts where the ray enters and leaves the slab between min and max
+func checkAxis(origin float64, direction float64, min float64, max float64) (float64, float64) {
+	if math.Abs(direction) < rtmath.EPSILON {
+		// Ray parallel to the slab is either always or never inside of it
+		if origin < min || origin > max {
+			return math.Inf(1), math.Inf(-1)
+		}
+		return math.Inf(-1), math.Inf(1)
+	}
+
+	tMin := (min - origin) / direction
+	tMax := (max - origin) / direction
+	if tMin > tMax {
+		tMin, tMax = tMax, tMin
+	}
+	return tMin, tMax
+}
+
+// Returns the ts where the ray enters and leaves the box; tMin > tMax on a miss
+func boundsRayTs(b Bounds, r Ray) (float64, float64) {
+	xtMin, xtMax := checkAxis(r.Origin.X, r.Direction.X, b.Min.X, b.Max.X)
+	ytMin, ytMax := checkAxis(r.Origin.Y, r.Direction.Y, b.Min.Y, b.Max.Y)
+	ztMin, ztMax := checkAxis(r.Origin.Z, r.Direction.Z, b.Min.Z, b.Max.Z)
+
+	tMin := math.Max(xtMin, math.Max(ytMin, ztMin))
+	tMax := math.Min(xtMax, math.Min(ytMax, ztMax))
+	return tMin, tMax
+}
+
+// Checks if the ray passes through the box. The box is padded by EPSILON so
+// that shapes touching its sides are never culled by mistake.
+func BoundsIntersects(b Bounds, r Ray) bool {
+	pad := rtmath.Vector(rtmath.EPSILON, rtmath.EPSILON, rtmath.EPSILON)
+	padded := Bounds{rtmath.TupleSubtract(b.Min, pad), rtmath.TupleAdd(b.Max, pad)}
+	tMin, tMax := boundsRayTs(padded, r)
+	return tMin <= tMax
+}
+
+// Bounds of the shape in the space of its parent. A moving shape could be
+// anywhere, so its bounds are unlimited.
+func ParentSpaceBounds(s Shape) Bounds {
+	if s.GetMotion() != nil {
+		return unlimitedBounds()
+	}
+	return BoundsTransform(s.LocalBounds(), s.GetTransform())
+}
+
+func unlimitedBounds() Bounds {
+	inf := math.Inf(1)
+	return Bounds{rtmath.Point(-inf, -inf, -inf), rtmath.Point(inf, inf, inf)}
+}
@@ -0,0 +1,172 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func boundsEqual(a Bounds, b Bounds) bool {
+	return rtmath.TupleEqual(a.Min, b.Min) && rtmath.TupleEqual(a.Max, b.Max)
+}
+
+func TestAddPointsToBounds(t *testing.T) {
+	b := NewBounds()
+	b = BoundsAddPoint(b, rtmath.Point(-5, 2, 0))
+	b = BoundsAddPoint(b, rtmath.Point(7, 0, -3))
+	expected := Bounds{rtmath.Point(-5, 0, -3), rtmath.Point(7, 2, 0)}
+	if !boundsEqual(b, expected) {
+		t.Errorf("Expected %v to be %v", b, expected)
+	}
+}
+
+func TestMergeBounds(t *testing.T) {
+	b1 := Bounds{rtmath.Point(-5, -2, 0), rtmath.Point(7, 4, 4)}
+	b2 := Bounds{rtmath.Point(8, -7, -2), rtmath.Point(14, 2, 8)}
+	b := BoundsMerge(b1, b2)
+	expected := Bounds{rtmath.Point(-5, -7, -2), rtmath.Point(14, 4, 8)}
+	if !boundsEqual(b, expected) {
+		t.Errorf("Expected %v to be %v", b, expected)
+	}
+}
+
+func TestBoundsContainPoint(t *testing.T) {
+	type testCase struct {
+		point    rtmath.Tuple
+		expected bool
+	}
+
+	cases := []testCase{
+		{rtmath.Point(5, -2, 0), true},
+		{rtmath.Point(11, 4, 7), true},
+		{rtmath.Point(8, 1, 3), true},
+		{rtmath.Point(3, 0, 3), false},
+		{rtmath.Point(8, -4, 3), false},
+		{rtmath.Point(8, 1, -1), false},
+		{rtmath.Point(13, 1, 3), false},
+		{rtmath.Point(8, 5, 3), false},
+		{rtmath.Point(8, 1, 8), false},
+	}
+
+	b := Bounds{rtmath.Point(5, -2, 0), rtmath.Point(11, 4, 7)}
+	for _, v := range cases {
+		if BoundsContainsPoint(b, v.point) != v.expected {
+			t.Errorf("Expected BoundsContainsPoint(%v) to be %v", v.point, v.expected)
+		}
+	}
+}
+
+func TestTransformBounds(t *testing.T) {
+	b := Bounds{rtmath.Point(-1, -1, -1), rtmath.Point(1, 1, 1)}
+	m, err := rtmath.Matrix4x4Multiply(rtmath.RotationX(math.Pi/4), rtmath.RotationY(math.Pi/4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := BoundsTransform(b, m)
+	expected := Bounds{rtmath.Point(-1.41421, -1.70710, -1.70710), rtmath.Point(1.41421, 1.70710, 1.70710)}
+	if !boundsEqual(res, expected) {
+		t.Errorf("Expected %v to be %v", res, expected)
+	}
+}
+
+func TestTransformUnboundedBounds(t *testing.T) {
+	p := NewPlane()
+	res := BoundsTransform(p.LocalBounds(), rtmath.RotationY(math.Pi/4))
+	if !math.IsInf(res.Min.X, -1) || !math.IsInf(res.Max.X, 1) ||
+		!math.IsInf(res.Min.Z, -1) || !math.IsInf(res.Max.Z, 1) ||
+		!rtmath.FloatEqual(res.Min.Y, 0) || !rtmath.FloatEqual(res.Max.Y, 0) {
+		t.Errorf("Expected a rotated plane to stay unbounded in x and z but got %v", res)
+	}
+}
+
+func TestShapeBounds(t *testing.T) {
+	cyl := NewCylinder()
+	cyl.Minimum = -5
+	cyl.Maximum = 3
+	cone := NewCone()
+	cone.Minimum = -5
+	cone.Maximum = 3
+
+	type testCase struct {
+		name     string
+		shape    Shape
+		expected Bounds
+	}
+
+	cases := []testCase{
+		{"sphere", NewSphere(), Bounds{rtmath.Point(-1, -1, -1), rtmath.Point(1, 1, 1)}},
+		{"cube", NewCube(), Bounds{rtmath.Point(-1, -1, -1), rtmath.Point(1, 1, 1)}},
+		{"cylinder", cyl, Bounds{rtmath.Point(-1, -5, -1), rtmath.Point(1, 3, 1)}},
+		{"cone", cone, Bounds{rtmath.Point(-5, -5, -5), rtmath.Point(5, 3, 5)}},
+		{"triangle", NewTriangle(rtmath.Point(-3, 7, 2), rtmath.Point(6, 2, -4), rtmath.Point(2, -1, -1)), Bounds{rtmath.Point(-3, -1, -4), rtmath.Point(6, 7, 2)}},
+	}
+
+	for _, v := range cases {
+		b := v.shape.LocalBounds()
+		if !boundsEqual(b, v.expected) {
+			t.Errorf("%s: expected %v to be %v", v.name, b, v.expected)
+		}
+	}
+}
+
+func TestGroupBoundsContainChildren(t *testing.T) {
+	s := NewSphere()
+	ts, err := rtmath.Transformation(rtmath.Scaling(2, 2, 2), rtmath.Translation(2, 5, -3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Transform = ts
+	c := NewCylinder()
+	c.Minimum = -2
+	c.Maximum = 2
+	ts, err = rtmath.Transformation(rtmath.Scaling(0.5, 1, 0.5), rtmath.Translation(-4, -1, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = ts
+	g := NewGroup()
+	g.AddChild(s)
+	g.AddChild(c)
+
+	b := g.LocalBounds()
+	expected := Bounds{rtmath.Point(-4.5, -3, -5), rtmath.Point(4, 7, 4.5)}
+	if !boundsEqual(b, expected) {
+		t.Errorf("Expected %v to be %v", b, expected)
+	}
+}
+
+func TestRayIntersectsBounds(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+		expected  bool
+	}
+
+	cases := []testCase{
+		{rtmath.Point(15, 1, 2), rtmath.Vector(-1, 0, 0), true},
+		{rtmath.Point(-5, -1, 4), rtmath.Vector(1, 0, 0), true},
+		{rtmath.Point(7, 6, 5), rtmath.Vector(0, -1, 0), true},
+		{rtmath.Point(9, -5, 6), rtmath.Vector(0, 1, 0), true},
+		{rtmath.Point(8, 2, 12), rtmath.Vector(0, 0, -1), true},
+		{rtmath.Point(6, 0, -5), rtmath.Vector(0, 0, 1), true},
+		{rtmath.Point(8, 1, 3.5), rtmath.Vector(0, 0, 1), true},
+		{rtmath.Point(9, -1, -8), rtmath.Vector(2, 4, 6), false},
+		{rtmath.Point(8, 3, -4), rtmath.Vector(6, 2, 4), false},
+		{rtmath.Point(9, -1, -2), rtmath.Vector(4, 6, 2), false},
+		{rtmath.Point(4, 0, 9), rtmath.Vector(0, 0, -1), false},
+		{rtmath.Point(8, 6, -1), rtmath.Vector(0, -1, 0), false},
+		{rtmath.Point(12, 5, 4), rtmath.Vector(-1, 0, 0), false},
+	}
+
+	b := Bounds{rtmath.Point(5, -2, 0), rtmath.Point(11, 4, 7)}
+	for _, v := range cases {
+		r, err := NewRay(v.origin, rtmath.VectorNormalize(v.direction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if BoundsIntersects(b, r) != v.expected {
+			t.Errorf("Expected BoundsIntersects(%v) to be %v", r, v.expected)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package geometry
+
+import (
+	"sort"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+// Nodes with at most this many primitives are not split any further
+const BVH_LEAF_SIZE = 4
+
+// A shape together with everything needed to intersect it without walking
+// its parent groups. Moving shapes have no fixed inverse, so theirs is
+// worked out for every ray.
+type bvhPrimitive struct {
+	shape    Shape
+	inverse  rtmath.Matrix
+	bounds   Bounds
+	centroid rtmath.Tuple
+	moving   bool
+}
+
+// BVH is a bounding volume hierarchy over the primitives of a scene. Groups
+// are flattened into their children so large meshes get split up too, while
+// unbounded shapes such as planes, and moving shapes, are kept aside and
+// always tested.
+type BVH struct {
+	Bounds     Bounds
+	Left       *BVH
+	Right      *BVH
+	primitives []bvhPrimitive
+	unbounded  []bvhPrimitive
+}
+
+// Builds a BVH over top level shapes, splitting nodes at the median
+// primitive along their longest axis
+func BuildBVH(shapes []Shape) (*BVH, error) {
+	prims := []bvhPrimitive{}
+	for _, s := range shapes {
+		var err error
+		prims, err = flattenShape(s, rtmath.MatrixConstructIdentity(4), prims)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bounded := []bvhPrimitive{}
+	unbounded := []bvhPrimitive{}
+	for _, p := range prims {
+		if BoundsIsFinite(p.bounds) {
+			bounded = append(bounded, p)
+		} else {
+			unbounded = append(unbounded, p)
+		}
+	}
+
+	root := buildBVHNode(bounded)
+	root.unbounded = unbounded
+	return root, nil
+}
+
+// Appends the primitives of s to prims, parent being the transform of
+// everything above s
+func flattenShape(s Shape, parent rtmath.Matrix, prims []bvhPrimitive) ([]bvhPrimitive, error) {
+	total, err := rtmath.Matrix4x4Multiply(parent, s.GetTransform())
+	if err != nil {
+		return nil, err
+	}
+
+	if g, ok := s.(*Group); ok {
+		for _, c := range g.Children {
+			prims, err = flattenShape(c, total, prims)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return prims, nil
+	}
+
+	if IsMoving(s) {
+		return append(prims, bvhPrimitive{s, rtmath.Matrix{}, unlimitedBounds(), rtmath.Point(0, 0, 0), true}), nil
+	}
+
+	inv, err := rtmath.MatrixInverse(total)
+	if err != nil {
+		return nil, err
+	}
+	b := BoundsTransform(s.LocalBounds(), total)
+	centroid := rtmath.Point((b.Min.X+b.Max.X)/2, (b.Min.Y+b.Max.Y)/2, (b.Min.Z+b.Max.Z)/2)
+
+	return append(prims, bvhPrimitive{s, inv, b, centroid, false}), nil
+}
+
+func buildBVHNode(prims []bvhPrimitive) *BVH {
+	node := &BVH{Bounds: NewBounds()}
+	centroids := NewBounds()
+	for _, p := range prims {
+		node.Bounds = BoundsMerge(node.Bounds, p.bounds)
+		centroids = BoundsAddPoint(centroids, p.centroid)
+	}
+
+	if len(prims) <= BVH_LEAF_SIZE {
+		node.primitives = prims
+		return node
+	}
+
+	// Split along the axis the centroids are most spread out on
+	extent := rtmath.TupleSubtract(centroids.Max, centroids.Min)
+	axis := func(t rtmath.Tuple) float64 { return t.X }
+	if extent.Y > extent.X && extent.Y >= extent.Z {
+		axis = func(t rtmath.Tuple) float64 { return t.Y }
+	} else if extent.Z > extent.X && extent.Z > extent.Y {
+		axis = func(t rtmath.Tuple) float64 { return t.Z }
+	}
+
+	sorted := make([]bvhPrimitive, len(prims))
+	copy(sorted, prims)
+	sort.Slice(sorted, func(i, j int) bool { return axis(sorted[i].centroid) < axis(sorted[j].centroid) })
+
+	mid := len(sorted) / 2
+	node.Left = buildBVHNode(sorted[:mid])
+	node.Right = buildBVHNode(sorted[mid:])
+	return node
+}
+
+func intersectPrimitive(p bvhPrimitive, r Ray) ([]Intersection, error) {
+	inv := p.inverse
+	if p.moving {
+		m, err := worldTransformAt(p.shape, r.Time)
+		if err != nil {
+			return []Intersection{}, err
+		}
+		inv, err = rtmath.MatrixInverse(m)
+		if err != nil {
+			return []Intersection{}, err
+		}
+	}
+	localRay, err := RayMatrixTransform(r, inv)
+	if err != nil {
+		return []Intersection{}, err
+	}
+	xs, err := p.shape.LocalIntersect(localRay)
+	if err != nil {
+		return []Intersection{}, err
+	}
+	return stampTime(xs, r.Time), nil
+}
+
+// Returns the sorted intersections of r with everything in the BVH
+func BVHIntersect(b *BVH, r Ray) ([]Intersection, error) {
+	xs := []Intersection{}
+	for _, p := range b.unbounded {
+		is, err := intersectPrimitive(p, r)
+		if err != nil {
+			return []Intersection{}, err
+		}
+		xs = append(xs, is...)
+	}
+
+	xs, err := bvhNodeIntersect(b, r, xs)
+	if err != nil {
+		return []Intersection{}, err
+	}
+	return SortIntersections(xs), nil
+}
+
+func bvhNodeIntersect(b *BVH, r Ray, xs []Intersection) ([]Intersection, error) {
+	if !BoundsIntersects(b.Bounds, r) {
+		return xs, nil
+	}
+
+	for _, p := range b.primitives {
+		is, err := intersectPrimitive(p, r)
+		if err != nil {
+			return nil, err
+		}
+		xs = append(xs, is...)
+	}
+
+	var err error
+	if b.Left != nil {
+		xs, err = bvhNodeIntersect(b.Left, r, xs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if b.Right != nil {
+		xs, err = bvhNodeIntersect(b.Right, r, xs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return xs, nil
+}
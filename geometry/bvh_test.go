@@ -0,0 +1,136 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestBVHSplitsPrimitives(t *testing.T) {
+	shapes := []Shape{}
+	for i := 0; i < 3*BVH_LEAF_SIZE; i++ {
+		s := NewSphere()
+		s.Transform = rtmath.Translation(float64(i)*3, 0, 0)
+		shapes = append(shapes, s)
+	}
+	b, err := BuildBVH(shapes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Left == nil || b.Right == nil {
+		t.Fatalf("Expected the root to be split but got %v", b)
+	}
+	expected := Bounds{rtmath.Point(-1, -1, -1), rtmath.Point(float64(3*BVH_LEAF_SIZE-1)*3+1, 1, 1)}
+	if !boundsEqual(b.Bounds, expected) {
+		t.Errorf("Expected %v to be %v", b.Bounds, expected)
+	}
+	if b.Left.Bounds.Max.X > b.Right.Bounds.Min.X {
+		t.Errorf("Expected children to be split along x but got %v and %v", b.Left.Bounds, b.Right.Bounds)
+	}
+}
+
+func TestBVHFlattensGroups(t *testing.T) {
+	g := NewGroup()
+	g.Transform = rtmath.Translation(0, 10, 0)
+	s := NewSphere()
+	s.Transform = rtmath.Scaling(2, 2, 2)
+	g.AddChild(s)
+	b, err := BuildBVH([]Shape{g})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.primitives) != 1 || b.primitives[0].shape != s {
+		t.Fatalf("Expected the group's sphere to be the only primitive but got %v", b.primitives)
+	}
+	expected := Bounds{rtmath.Point(-2, 8, -2), rtmath.Point(2, 12, 2)}
+	if !boundsEqual(b.Bounds, expected) {
+		t.Errorf("Expected %v to be %v", b.Bounds, expected)
+	}
+}
+
+func TestBVHIntersectMatchesLinearScan(t *testing.T) {
+	shapes := []Shape{NewPlane()}
+	g := NewGroup()
+	g.Transform = rtmath.RotationY(math.Pi / 6)
+	for i := 0; i < 20; i++ {
+		s := NewSphere()
+		s.Transform = rtmath.Translation(float64(i%5)*2.5-5, float64(i/5)*2.5+1, 0)
+		g.AddChild(s)
+	}
+	shapes = append(shapes, g, NewCSG(CSG_DIFFERENCE, NewCube(), NewSphere()))
+	b, err := BuildBVH(shapes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for x := -6.0; x <= 6; x += 0.75 {
+		for y := -1.0; y <= 12; y += 0.75 {
+			r, err := NewRay(rtmath.Point(0, 5, -20), rtmath.VectorNormalize(rtmath.Vector(x, y-5, 20)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := []Intersection{}
+			for _, s := range shapes {
+				xs, err := Intersect(s, r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				expected = append(expected, xs...)
+			}
+			expected = SortIntersections(expected)
+			xs, err := BVHIntersect(b, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(xs) != len(expected) {
+				t.Fatalf("Expected %v to equal %v", xs, expected)
+			}
+			for i := range xs {
+				if xs[i].Object != expected[i].Object || !rtmath.FloatEqual(xs[i].T, expected[i].T) {
+					t.Fatalf("Expected %v to equal %v", xs, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestBVHIntersectsMovingShapesAtTimeOfRay(t *testing.T) {
+	g := NewGroup()
+	g.Motion = LinearMotion(rtmath.Translation(0, 0, 0), rtmath.Translation(6, 0, 0))
+	moving := NewSphere()
+	g.AddChild(moving)
+	still := NewSphere()
+	still.Transform = rtmath.Translation(0, 3, 0)
+	b, err := BuildBVH([]Shape{g, still})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.unbounded) != 1 || b.unbounded[0].shape != moving {
+		t.Fatalf("Expected the moving sphere to be kept aside but got %v", b.unbounded)
+	}
+
+	for _, time := range []float64{0, 0.25, 0.5, 1} {
+		r, err := NewRay(rtmath.Point(3, 0, -5), rtmath.Vector(0, 0, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Time = time
+		expected, err := Intersect(g, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := BVHIntersect(b, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != len(expected) {
+			t.Fatalf("Expected %v at time %v to equal %v", xs, time, expected)
+		}
+		for i := range xs {
+			if xs[i] != expected[i] {
+				t.Errorf("Expected %v at time %v to equal %v", xs, time, expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package geometry
+
+import (
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+// Cone is the double-napped cone x^2 + z^2 = y^2, truncated to (Minimum, Maximum)
+type Cone struct {
+	BaseShape
+	Minimum float64
+	Maximum float64
+	Closed  bool
+}
+
+func NewCone() *Cone {
+	return &Cone{newBaseShape(), math.Inf(-1), math.Inf(1), false}
+}
+
+func (c *Cone) LocalIntersect(r Ray) ([]Intersection, error) {
+	xs := []Intersection{}
+
+	a := r.Direction.X*r.Direction.X - r.Direction.Y*r.Direction.Y + r.Direction.Z*r.Direction.Z
+	b := 2*r.Origin.X*r.Direction.X - 2*r.Origin.Y*r.Direction.Y + 2*r.Origin.Z*r.Direction.Z
+	c2 := r.Origin.X*r.Origin.X - r.Origin.Y*r.Origin.Y + r.Origin.Z*r.Origin.Z
+
+	inRange := func(t float64) bool {
+		y := r.Origin.Y + t*r.Direction.Y
+		return c.Minimum < y && y < c.Maximum
+	}
+
+	if math.Abs(a) < rtmath.EPSILON {
+		// Ray parallel to one of the cone's halves hits the other half once
+		if math.Abs(b) >= rtmath.EPSILON {
+			t := -c2 / (2 * b)
+			if inRange(t) {
+				xs = append(xs, NewIntersection(c, t))
+			}
+		}
+	} else {
+		disc := b*b - 4*a*c2
+		if disc < 0 {
+			return xs, nil
+		}
+
+		t0 := (-b - math.Sqrt(disc)) / (2 * a)
+		t1 := (-b + math.Sqrt(disc)) / (2 * a)
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+
+		if inRange(t0) {
+			xs = append(xs, NewIntersection(c, t0))
+		}
+		if inRange(t1) {
+			xs = append(xs, NewIntersection(c, t1))
+		}
+	}
+
+	return append(xs, intersectCaps(c, r, c.Minimum, c.Maximum, c.Closed, math.Abs)...), nil
+}
+
+func (c *Cone) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	dist := p.X*p.X + p.Z*p.Z
+
+	if dist < c.Maximum*c.Maximum && p.Y >= c.Maximum-rtmath.EPSILON {
+		return rtmath.Vector(0, 1, 0)
+	}
+	if dist < c.Minimum*c.Minimum && p.Y <= c.Minimum+rtmath.EPSILON {
+		return rtmath.Vector(0, -1, 0)
+	}
+
+	y := math.Sqrt(dist)
+	if p.Y > 0 {
+		y = -y
+	}
+	return rtmath.Vector(p.X, y, p.Z)
+}
+
+func (c *Cone) LocalBounds() Bounds {
+	limit := math.Max(math.Abs(c.Minimum), math.Abs(c.Maximum))
+	return Bounds{rtmath.Point(-limit, c.Minimum, -limit), rtmath.Point(limit, c.Maximum, limit)}
+}
@@ -0,0 +1,106 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestIntersectCone(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+		t0        float64
+		t1        float64
+	}
+
+	cases := []testCase{
+		{rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1), 5, 5},
+		{rtmath.Point(0, 0, -5), rtmath.Vector(1, 1, 1), 8.66025, 8.66025},
+		{rtmath.Point(1, 1, -5), rtmath.Vector(-0.5, -1, 1), 4.55006, 49.44994},
+	}
+
+	c := NewCone()
+	for _, v := range cases {
+		r, err := NewRay(v.origin, rtmath.VectorNormalize(v.direction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != 2 || !rtmath.FloatEqual(xs[0].T, v.t0) || !rtmath.FloatEqual(xs[1].T, v.t1) {
+			t.Errorf("Expected ts to be [%f %f] but got %v", v.t0, v.t1, xs)
+		}
+	}
+}
+
+func TestIntersectConeWithRayParallelToOneHalf(t *testing.T) {
+	c := NewCone()
+	r, err := NewRay(rtmath.Point(0, 0, -1), rtmath.VectorNormalize(rtmath.Vector(0, 1, 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := c.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 1 || !rtmath.FloatEqual(xs[0].T, 0.35355) {
+		t.Errorf("Expected a single intersection at 0.35355 but got %v", xs)
+	}
+}
+
+func TestIntersectCapsOfClosedCone(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+		count     int
+	}
+
+	cases := []testCase{
+		{rtmath.Point(0, 0, -5), rtmath.Vector(0, 1, 0), 0},
+		{rtmath.Point(0, 0, -0.25), rtmath.Vector(0, 1, 1), 2},
+		{rtmath.Point(0, 0, -0.25), rtmath.Vector(0, 1, 0), 4},
+	}
+
+	c := NewCone()
+	c.Minimum = -0.5
+	c.Maximum = 0.5
+	c.Closed = true
+	for _, v := range cases {
+		r, err := NewRay(v.origin, rtmath.VectorNormalize(v.direction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != v.count {
+			t.Errorf("Expected %d intersections for %v but got %v", v.count, r, xs)
+		}
+	}
+}
+
+func TestNormalOnCone(t *testing.T) {
+	type testCase struct {
+		point  rtmath.Tuple
+		normal rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{rtmath.Point(0, 0, 0), rtmath.Vector(0, 0, 0)},
+		{rtmath.Point(1, 1, 1), rtmath.Vector(1, -math.Sqrt2, 1)},
+		{rtmath.Point(-1, -1, 0), rtmath.Vector(-1, 1, 0)},
+	}
+
+	c := NewCone()
+	for _, v := range cases {
+		n := c.LocalNormalAt(v.point, Intersection{})
+		if !rtmath.TupleEqual(n, v.normal) {
+			t.Errorf("Expected normal at %v to be %v but got %v", v.point, v.normal, n)
+		}
+	}
+}
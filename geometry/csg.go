@@ -0,0 +1,111 @@
+package geometry
+
+import (
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+type CSGOperation int
+
+const (
+	CSG_UNION CSGOperation = iota
+	CSG_INTERSECTION
+	CSG_DIFFERENCE
+)
+
+// CSG combines two shapes with a set operation
+type CSG struct {
+	BaseShape
+	Operation CSGOperation
+	Left      Shape
+	Right     Shape
+}
+
+func NewCSG(op CSGOperation, left Shape, right Shape) *CSG {
+	c := &CSG{newBaseShape(), op, left, right}
+	left.SetParent(c)
+	right.SetParent(c)
+	return c
+}
+
+// Decides if an intersection is part of the CSG's surface given whether it
+// hits the left shape and whether it happens inside the left and right shapes
+func IntersectionAllowed(op CSGOperation, leftHit bool, inLeft bool, inRight bool) bool {
+	switch op {
+	case CSG_UNION:
+		return (leftHit && !inRight) || (!leftHit && !inLeft)
+	case CSG_INTERSECTION:
+		return (leftHit && inRight) || (!leftHit && inLeft)
+	case CSG_DIFFERENCE:
+		return (leftHit && !inRight) || (!leftHit && inLeft)
+	}
+	return false
+}
+
+// Checks if target is s or, for groups and CSGs, anywhere inside s
+func includes(s Shape, target Shape) bool {
+	switch v := s.(type) {
+	case *Group:
+		for _, c := range v.Children {
+			if includes(c, target) {
+				return true
+			}
+		}
+		return false
+	case *CSG:
+		return includes(v.Left, target) || includes(v.Right, target)
+	}
+	return s == target
+}
+
+// Keeps the sorted intersections that lie on the CSG's surface
+func FilterIntersections(c *CSG, xs []Intersection) []Intersection {
+	// Rays start outside of both shapes
+	inLeft := false
+	inRight := false
+
+	result := []Intersection{}
+	for _, i := range xs {
+		leftHit := includes(c.Left, i.Object)
+		if IntersectionAllowed(c.Operation, leftHit, inLeft, inRight) {
+			result = append(result, i)
+		}
+
+		if leftHit {
+			inLeft = !inLeft
+		} else {
+			inRight = !inRight
+		}
+	}
+	return result
+}
+
+func (c *CSG) LocalIntersect(r Ray) ([]Intersection, error) {
+	leftXs, err := Intersect(c.Left, r)
+	if err != nil {
+		return []Intersection{}, err
+	}
+	rightXs, err := Intersect(c.Right, r)
+	if err != nil {
+		return []Intersection{}, err
+	}
+
+	xs := SortIntersections(append(leftXs, rightXs...))
+	return FilterIntersections(c, xs), nil
+}
+
+// Intersections always refer to a CSG's children, never to the CSG itself
+func (c *CSG) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	panic("LocalNormalAt called on a CSG")
+}
+
+// Sets the material of the CSG and of both of its children
+func (c *CSG) SetMaterial(m shading.Material) {
+	c.Material = m
+	c.Left.SetMaterial(m)
+	c.Right.SetMaterial(m)
+}
+
+func (c *CSG) LocalBounds() Bounds {
+	return BoundsMerge(ParentSpaceBounds(c.Left), ParentSpaceBounds(c.Right))
+}
@@ -0,0 +1,133 @@
+package geometry
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestCreateCSG(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewCube()
+	c := NewCSG(CSG_UNION, s1, s2)
+	if c.Operation != CSG_UNION || c.Left != s1 || c.Right != s2 || s1.GetParent() != c || s2.GetParent() != c {
+		t.Errorf("CSG was not constructed correctly, got %v", c)
+	}
+}
+
+func TestCSGOperationRules(t *testing.T) {
+	type testCase struct {
+		op      CSGOperation
+		leftHit bool
+		inLeft  bool
+		inRight bool
+		result  bool
+	}
+
+	cases := []testCase{
+		{CSG_UNION, true, true, true, false},
+		{CSG_UNION, true, true, false, true},
+		{CSG_UNION, true, false, true, false},
+		{CSG_UNION, true, false, false, true},
+		{CSG_UNION, false, true, true, false},
+		{CSG_UNION, false, true, false, false},
+		{CSG_UNION, false, false, true, true},
+		{CSG_UNION, false, false, false, true},
+		{CSG_INTERSECTION, true, true, true, true},
+		{CSG_INTERSECTION, true, true, false, false},
+		{CSG_INTERSECTION, true, false, true, true},
+		{CSG_INTERSECTION, true, false, false, false},
+		{CSG_INTERSECTION, false, true, true, true},
+		{CSG_INTERSECTION, false, true, false, true},
+		{CSG_INTERSECTION, false, false, true, false},
+		{CSG_INTERSECTION, false, false, false, false},
+		{CSG_DIFFERENCE, true, true, true, false},
+		{CSG_DIFFERENCE, true, true, false, true},
+		{CSG_DIFFERENCE, true, false, true, false},
+		{CSG_DIFFERENCE, true, false, false, true},
+		{CSG_DIFFERENCE, false, true, true, true},
+		{CSG_DIFFERENCE, false, true, false, true},
+		{CSG_DIFFERENCE, false, false, true, false},
+		{CSG_DIFFERENCE, false, false, false, false},
+	}
+
+	for _, v := range cases {
+		res := IntersectionAllowed(v.op, v.leftHit, v.inLeft, v.inRight)
+		if res != v.result {
+			t.Errorf("Expected IntersectionAllowed(%v) to be %v but got %v", v, v.result, res)
+		}
+	}
+}
+
+func TestFilterListOfIntersections(t *testing.T) {
+	type testCase struct {
+		op CSGOperation
+		x0 int
+		x1 int
+	}
+
+	cases := []testCase{
+		{CSG_UNION, 0, 3},
+		{CSG_INTERSECTION, 1, 2},
+		{CSG_DIFFERENCE, 0, 1},
+	}
+
+	for _, v := range cases {
+		s1 := NewSphere()
+		s2 := NewCube()
+		c := NewCSG(v.op, s1, s2)
+		xs := Intersections(NewIntersection(s1, 1), NewIntersection(s2, 2), NewIntersection(s1, 3), NewIntersection(s2, 4))
+		res := FilterIntersections(c, xs)
+		if len(res) != 2 || res[0] != xs[v.x0] || res[1] != xs[v.x1] {
+			t.Errorf("Expected op %d to keep intersections %d and %d but got %v", v.op, v.x0, v.x1, res)
+		}
+	}
+}
+
+func TestFilterIntersectionsWithNestedChildren(t *testing.T) {
+	s1 := NewSphere()
+	g := NewGroup()
+	g.AddChild(s1)
+	s2 := NewCube()
+	c := NewCSG(CSG_DIFFERENCE, g, s2)
+	xs := Intersections(NewIntersection(s1, 1), NewIntersection(s2, 2), NewIntersection(s1, 3), NewIntersection(s2, 4))
+	res := FilterIntersections(c, xs)
+	if len(res) != 2 || res[0] != xs[0] || res[1] != xs[1] {
+		t.Errorf("Expected intersections 0 and 1 to be kept but got %v", res)
+	}
+}
+
+func TestRayMissesCSG(t *testing.T) {
+	c := NewCSG(CSG_UNION, NewSphere(), NewCube())
+	r, err := NewRay(rtmath.Point(0, 2, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := c.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 0 {
+		t.Errorf("Expected %v to be empty", xs)
+	}
+}
+
+func TestRayHitsCSG(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewSphere()
+	s2.Transform = rtmath.Translation(0, 0, 0.5)
+	c := NewCSG(CSG_UNION, s1, s2)
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := c.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 2 ||
+		!rtmath.FloatEqual(xs[0].T, 4) || xs[0].Object != s1 ||
+		!rtmath.FloatEqual(xs[1].T, 6.5) || xs[1].Object != s2 {
+		t.Errorf("Expected hits at 4 on s1 and 6.5 on s2 but got %v", xs)
+	}
+}
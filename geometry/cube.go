@@ -0,0 +1,41 @@
+package geometry
+
+import (
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+// Cube is the axis-aligned box spanning -1 to 1 on every axis in object space
+type Cube struct {
+	BaseShape
+}
+
+func NewCube() *Cube {
+	return &Cube{newBaseShape()}
+}
+
+func (c *Cube) LocalIntersect(r Ray) ([]Intersection, error) {
+	tMin, tMax := boundsRayTs(c.LocalBounds(), r)
+	if tMin > tMax {
+		return []Intersection{}, nil
+	}
+
+	return []Intersection{NewIntersection(c, tMin), NewIntersection(c, tMax)}, nil
+}
+
+func (c *Cube) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	maxC := math.Max(math.Abs(p.X), math.Max(math.Abs(p.Y), math.Abs(p.Z)))
+
+	if maxC == math.Abs(p.X) {
+		return rtmath.Vector(p.X, 0, 0)
+	}
+	if maxC == math.Abs(p.Y) {
+		return rtmath.Vector(0, p.Y, 0)
+	}
+	return rtmath.Vector(0, 0, p.Z)
+}
+
+func (c *Cube) LocalBounds() Bounds {
+	return Bounds{rtmath.Point(-1, -1, -1), rtmath.Point(1, 1, 1)}
+}
@@ -0,0 +1,99 @@
+package geometry
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestRayIntersectsCube(t *testing.T) {
+	type testCase struct {
+		name      string
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+		t1        float64
+		t2        float64
+	}
+
+	cases := []testCase{
+		{"+x", rtmath.Point(5, 0.5, 0), rtmath.Vector(-1, 0, 0), 4, 6},
+		{"-x", rtmath.Point(-5, 0.5, 0), rtmath.Vector(1, 0, 0), 4, 6},
+		{"+y", rtmath.Point(0.5, 5, 0), rtmath.Vector(0, -1, 0), 4, 6},
+		{"-y", rtmath.Point(0.5, -5, 0), rtmath.Vector(0, 1, 0), 4, 6},
+		{"+z", rtmath.Point(0.5, 0, 5), rtmath.Vector(0, 0, -1), 4, 6},
+		{"-z", rtmath.Point(0.5, 0, -5), rtmath.Vector(0, 0, 1), 4, 6},
+		{"inside", rtmath.Point(0, 0.5, 0), rtmath.Vector(0, 0, 1), -1, 1},
+	}
+
+	c := NewCube()
+	for _, v := range cases {
+		r, err := NewRay(v.origin, v.direction)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != 2 || !rtmath.FloatEqual(xs[0].T, v.t1) || !rtmath.FloatEqual(xs[1].T, v.t2) {
+			t.Errorf("%s: expected ts to be [%f %f] but got %v", v.name, v.t1, v.t2, xs)
+		}
+	}
+}
+
+func TestRayMissesCube(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{rtmath.Point(-2, 0, 0), rtmath.Vector(0.2673, 0.5345, 0.8018)},
+		{rtmath.Point(0, -2, 0), rtmath.Vector(0.8018, 0.2673, 0.5345)},
+		{rtmath.Point(0, 0, -2), rtmath.Vector(0.5345, 0.8018, 0.2673)},
+		{rtmath.Point(2, 0, 2), rtmath.Vector(0, 0, -1)},
+		{rtmath.Point(0, 2, 2), rtmath.Vector(0, -1, 0)},
+		{rtmath.Point(2, 2, 0), rtmath.Vector(-1, 0, 0)},
+	}
+
+	c := NewCube()
+	for _, v := range cases {
+		r, err := NewRay(v.origin, v.direction)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != 0 {
+			t.Errorf("Expected ray %v to miss the cube but got %v", r, xs)
+		}
+	}
+}
+
+func TestNormalOnSurfaceOfCube(t *testing.T) {
+	type testCase struct {
+		point  rtmath.Tuple
+		normal rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{rtmath.Point(1, 0.5, -0.8), rtmath.Vector(1, 0, 0)},
+		{rtmath.Point(-1, -0.2, 0.9), rtmath.Vector(-1, 0, 0)},
+		{rtmath.Point(-0.4, 1, -0.1), rtmath.Vector(0, 1, 0)},
+		{rtmath.Point(0.3, -1, -0.7), rtmath.Vector(0, -1, 0)},
+		{rtmath.Point(-0.6, 0.3, 1), rtmath.Vector(0, 0, 1)},
+		{rtmath.Point(0.4, 0.4, -1), rtmath.Vector(0, 0, -1)},
+		{rtmath.Point(1, 1, 1), rtmath.Vector(1, 0, 0)},
+		{rtmath.Point(-1, -1, -1), rtmath.Vector(-1, 0, 0)},
+	}
+
+	c := NewCube()
+	for _, v := range cases {
+		n := c.LocalNormalAt(v.point, Intersection{})
+		if !rtmath.TupleEqual(n, v.normal) {
+			t.Errorf("Expected normal at %v to be %v but got %v", v.point, v.normal, n)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package geometry
+
+import (
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+// Cylinder has radius 1 around the y axis, truncated to (Minimum, Maximum)
+type Cylinder struct {
+	BaseShape
+	Minimum float64
+	Maximum float64
+	Closed  bool
+}
+
+func NewCylinder() *Cylinder {
+	return &Cylinder{newBaseShape(), math.Inf(-1), math.Inf(1), false}
+}
+
+func (c *Cylinder) LocalIntersect(r Ray) ([]Intersection, error) {
+	xs := []Intersection{}
+
+	a := r.Direction.X*r.Direction.X + r.Direction.Z*r.Direction.Z
+	// Ray parallel to the y axis can only hit the caps
+	if math.Abs(a) >= rtmath.EPSILON {
+		b := 2*r.Origin.X*r.Direction.X + 2*r.Origin.Z*r.Direction.Z
+		c2 := r.Origin.X*r.Origin.X + r.Origin.Z*r.Origin.Z - 1
+		disc := b*b - 4*a*c2
+		if disc < 0 {
+			return xs, nil
+		}
+
+		t0 := (-b - math.Sqrt(disc)) / (2 * a)
+		t1 := (-b + math.Sqrt(disc)) / (2 * a)
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+
+		y0 := r.Origin.Y + t0*r.Direction.Y
+		if c.Minimum < y0 && y0 < c.Maximum {
+			xs = append(xs, NewIntersection(c, t0))
+		}
+		y1 := r.Origin.Y + t1*r.Direction.Y
+		if c.Minimum < y1 && y1 < c.Maximum {
+			xs = append(xs, NewIntersection(c, t1))
+		}
+	}
+
+	return append(xs, intersectCaps(c, r, c.Minimum, c.Maximum, c.Closed, func(y float64) float64 { return 1 })...), nil
+}
+
+func (c *Cylinder) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	dist := p.X*p.X + p.Z*p.Z
+
+	if dist < 1 && p.Y >= c.Maximum-rtmath.EPSILON {
+		return rtmath.Vector(0, 1, 0)
+	}
+	if dist < 1 && p.Y <= c.Minimum+rtmath.EPSILON {
+		return rtmath.Vector(0, -1, 0)
+	}
+	return rtmath.Vector(p.X, 0, p.Z)
+}
+
+// Checks if the intersection at t is within radius of the y axis
+func checkCap(r Ray, t float64, radius float64) bool {
+	x := r.Origin.X + t*r.Direction.X
+	z := r.Origin.Z + t*r.Direction.Z
+
+	return x*x+z*z <= radius*radius
+}
+
+// Intersects the end caps of a closed cylinder or cone; radius gives the
+// cap's radius at a given y
+func intersectCaps(s Shape, r Ray, minimum float64, maximum float64, closed bool, radius func(y float64) float64) []Intersection {
+	xs := []Intersection{}
+	if !closed || math.Abs(r.Direction.Y) < rtmath.EPSILON {
+		return xs
+	}
+
+	t := (minimum - r.Origin.Y) / r.Direction.Y
+	if checkCap(r, t, radius(minimum)) {
+		xs = append(xs, NewIntersection(s, t))
+	}
+
+	t = (maximum - r.Origin.Y) / r.Direction.Y
+	if checkCap(r, t, radius(maximum)) {
+		xs = append(xs, NewIntersection(s, t))
+	}
+	return xs
+}
+
+func (c *Cylinder) LocalBounds() Bounds {
+	return Bounds{rtmath.Point(-1, c.Minimum, -1), rtmath.Point(1, c.Maximum, 1)}
+}
@@ -0,0 +1,190 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestRayMissesCylinder(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{rtmath.Point(1, 0, 0), rtmath.Vector(0, 1, 0)},
+		{rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0)},
+		{rtmath.Point(0, 0, -5), rtmath.Vector(1, 1, 1)},
+	}
+
+	c := NewCylinder()
+	for _, v := range cases {
+		r, err := NewRay(v.origin, rtmath.VectorNormalize(v.direction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != 0 {
+			t.Errorf("Expected ray %v to miss the cylinder but got %v", r, xs)
+		}
+	}
+}
+
+func TestRayStrikesCylinder(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+		t0        float64
+		t1        float64
+	}
+
+	cases := []testCase{
+		{rtmath.Point(1, 0, -5), rtmath.Vector(0, 0, 1), 5, 5},
+		{rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1), 4, 6},
+		{rtmath.Point(0.5, 0, -5), rtmath.Vector(0.1, 1, 1), 6.80798, 7.08872},
+	}
+
+	c := NewCylinder()
+	for _, v := range cases {
+		r, err := NewRay(v.origin, rtmath.VectorNormalize(v.direction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != 2 || !rtmath.FloatEqual(xs[0].T, v.t0) || !rtmath.FloatEqual(xs[1].T, v.t1) {
+			t.Errorf("Expected ts to be [%f %f] but got %v", v.t0, v.t1, xs)
+		}
+	}
+}
+
+func TestNormalOnCylinder(t *testing.T) {
+	type testCase struct {
+		point  rtmath.Tuple
+		normal rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{rtmath.Point(1, 0, 0), rtmath.Vector(1, 0, 0)},
+		{rtmath.Point(0, 5, -1), rtmath.Vector(0, 0, -1)},
+		{rtmath.Point(0, -2, 1), rtmath.Vector(0, 0, 1)},
+		{rtmath.Point(-1, 1, 0), rtmath.Vector(-1, 0, 0)},
+	}
+
+	c := NewCylinder()
+	for _, v := range cases {
+		n := c.LocalNormalAt(v.point, Intersection{})
+		if !rtmath.TupleEqual(n, v.normal) {
+			t.Errorf("Expected normal at %v to be %v but got %v", v.point, v.normal, n)
+		}
+	}
+}
+
+func TestDefaultCylinder(t *testing.T) {
+	c := NewCylinder()
+	if !math.IsInf(c.Minimum, -1) || !math.IsInf(c.Maximum, 1) || c.Closed {
+		t.Errorf("Expected an infinite, open cylinder but got %v", c)
+	}
+}
+
+func TestIntersectConstrainedCylinder(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+		count     int
+	}
+
+	cases := []testCase{
+		{rtmath.Point(0, 1.5, 0), rtmath.Vector(0.1, 1, 0), 0},
+		{rtmath.Point(0, 3, -5), rtmath.Vector(0, 0, 1), 0},
+		{rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1), 0},
+		{rtmath.Point(0, 2, -5), rtmath.Vector(0, 0, 1), 0},
+		{rtmath.Point(0, 1, -5), rtmath.Vector(0, 0, 1), 0},
+		{rtmath.Point(0, 1.5, -2), rtmath.Vector(0, 0, 1), 2},
+	}
+
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	for _, v := range cases {
+		r, err := NewRay(v.origin, rtmath.VectorNormalize(v.direction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != v.count {
+			t.Errorf("Expected %d intersections for %v but got %v", v.count, r, xs)
+		}
+	}
+}
+
+func TestIntersectCapsOfClosedCylinder(t *testing.T) {
+	type testCase struct {
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+		count     int
+	}
+
+	cases := []testCase{
+		{rtmath.Point(0, 3, 0), rtmath.Vector(0, -1, 0), 2},
+		{rtmath.Point(0, 3, -2), rtmath.Vector(0, -1, 2), 2},
+		{rtmath.Point(0, 4, -2), rtmath.Vector(0, -1, 1), 2},
+		{rtmath.Point(0, 0, -2), rtmath.Vector(0, 1, 2), 2},
+		{rtmath.Point(0, -1, -2), rtmath.Vector(0, 1, 1), 2},
+	}
+
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	c.Closed = true
+	for _, v := range cases {
+		r, err := NewRay(v.origin, rtmath.VectorNormalize(v.direction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := c.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != v.count {
+			t.Errorf("Expected %d intersections for %v but got %v", v.count, r, xs)
+		}
+	}
+}
+
+func TestNormalOnCylinderCaps(t *testing.T) {
+	type testCase struct {
+		point  rtmath.Tuple
+		normal rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{rtmath.Point(0, 1, 0), rtmath.Vector(0, -1, 0)},
+		{rtmath.Point(0.5, 1, 0), rtmath.Vector(0, -1, 0)},
+		{rtmath.Point(0, 1, 0.5), rtmath.Vector(0, -1, 0)},
+		{rtmath.Point(0, 2, 0), rtmath.Vector(0, 1, 0)},
+		{rtmath.Point(0.5, 2, 0), rtmath.Vector(0, 1, 0)},
+		{rtmath.Point(0, 2, 0.5), rtmath.Vector(0, 1, 0)},
+	}
+
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	c.Closed = true
+	for _, v := range cases {
+		n := c.LocalNormalAt(v.point, Intersection{})
+		if !rtmath.TupleEqual(n, v.normal) {
+			t.Errorf("Expected normal at %v to be %v but got %v", v.point, v.normal, n)
+		}
+	}
+}
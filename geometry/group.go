@@ -0,0 +1,55 @@
+package geometry
+
+import (
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+// Group is a collection of shapes sharing the group's transform
+type Group struct {
+	BaseShape
+	Children []Shape
+}
+
+func NewGroup() *Group {
+	return &Group{newBaseShape(), []Shape{}}
+}
+
+func (g *Group) AddChild(s Shape) {
+	s.SetParent(g)
+	g.Children = append(g.Children, s)
+}
+
+func (g *Group) LocalIntersect(r Ray) ([]Intersection, error) {
+	xs := []Intersection{}
+	for _, c := range g.Children {
+		is, err := Intersect(c, r)
+		if err != nil {
+			return []Intersection{}, err
+		}
+		xs = append(xs, is...)
+	}
+
+	return SortIntersections(xs), nil
+}
+
+// Intersections always refer to a group's children, never to the group itself
+func (g *Group) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	panic("LocalNormalAt called on a group")
+}
+
+// Sets the material of the group and of everything in it
+func (g *Group) SetMaterial(m shading.Material) {
+	g.Material = m
+	for _, c := range g.Children {
+		c.SetMaterial(m)
+	}
+}
+
+func (g *Group) LocalBounds() Bounds {
+	b := NewBounds()
+	for _, c := range g.Children {
+		b = BoundsMerge(b, ParentSpaceBounds(c))
+	}
+	return b
+}
@@ -0,0 +1,156 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+func TestCreateGroup(t *testing.T) {
+	g := NewGroup()
+	if !rtmath.MatrixEqual(g.Transform, rtmath.MatrixConstructIdentity(4)) || len(g.Children) != 0 {
+		t.Errorf("Expected an empty group with identity transform but got %v", g)
+	}
+}
+
+func TestAddChildToGroup(t *testing.T) {
+	g := NewGroup()
+	s := newTestShape()
+	g.AddChild(s)
+	if len(g.Children) != 1 || g.Children[0] != s || s.GetParent() != g {
+		t.Errorf("Expected %v to be the only child of %v", s, g)
+	}
+}
+
+func TestIntersectEmptyGroup(t *testing.T) {
+	g := NewGroup()
+	r, err := NewRay(rtmath.Point(0, 0, 0), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := g.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 0 {
+		t.Errorf("Expected %v to be empty", xs)
+	}
+}
+
+func TestIntersectNonEmptyGroup(t *testing.T) {
+	g := NewGroup()
+	s1 := NewSphere()
+	s2 := NewSphere()
+	s2.Transform = rtmath.Translation(0, 0, -3)
+	s3 := NewSphere()
+	s3.Transform = rtmath.Translation(5, 0, 0)
+	g.AddChild(s1)
+	g.AddChild(s2)
+	g.AddChild(s3)
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := g.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 4 || xs[0].Object != s2 || xs[1].Object != s2 || xs[2].Object != s1 || xs[3].Object != s1 {
+		t.Errorf("Expected hits on s2, s2, s1, s1 but got %v", xs)
+	}
+}
+
+func TestIntersectTransformedGroup(t *testing.T) {
+	g := NewGroup()
+	g.Transform = rtmath.Scaling(2, 2, 2)
+	s := NewSphere()
+	s.Transform = rtmath.Translation(5, 0, 0)
+	g.AddChild(s)
+	r, err := NewRay(rtmath.Point(10, 0, -10), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := Intersect(g, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 2 {
+		t.Errorf("Expected 2 intersections but got %v", xs)
+	}
+}
+
+func TestSetMaterialOnGroupSetsChildren(t *testing.T) {
+	g := NewGroup()
+	inner := NewGroup()
+	s := NewSphere()
+	inner.AddChild(s)
+	g.AddChild(inner)
+	m := shading.NewMaterial()
+	m.Ambient = 1
+	g.SetMaterial(m)
+	if !rtmath.FloatEqual(s.Material.Ambient, 1) {
+		t.Errorf("Expected the material to reach %v", s)
+	}
+}
+
+func TestConvertPointFromWorldToObjectSpace(t *testing.T) {
+	g1 := NewGroup()
+	g1.Transform = rtmath.RotationY(math.Pi / 2)
+	g2 := NewGroup()
+	g2.Transform = rtmath.Scaling(2, 2, 2)
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.Transform = rtmath.Translation(5, 0, 0)
+	g2.AddChild(s)
+
+	p, err := WorldToObject(s, rtmath.Point(-2, 0, -10), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Point(0, 0, -1)
+	if !rtmath.TupleEqual(p, expected) {
+		t.Errorf("Expected %v to be %v", p, expected)
+	}
+}
+
+func TestConvertNormalFromObjectToWorldSpace(t *testing.T) {
+	g1 := NewGroup()
+	g1.Transform = rtmath.RotationY(math.Pi / 2)
+	g2 := NewGroup()
+	g2.Transform = rtmath.Scaling(1, 2, 3)
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.Transform = rtmath.Translation(5, 0, 0)
+	g2.AddChild(s)
+
+	n, err := NormalToWorld(s, rtmath.Vector(math.Sqrt(3)/3, math.Sqrt(3)/3, math.Sqrt(3)/3), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0.28571, 0.42857, -0.85714)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v", n, expected)
+	}
+}
+
+func TestNormalOnChildObject(t *testing.T) {
+	g1 := NewGroup()
+	g1.Transform = rtmath.RotationY(math.Pi / 2)
+	g2 := NewGroup()
+	g2.Transform = rtmath.Scaling(1, 2, 3)
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.Transform = rtmath.Translation(5, 0, 0)
+	g2.AddChild(s)
+
+	n, err := NormalAt(s, rtmath.Point(1.7321, 1.1547, -5.5774), Intersection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0.2857, 0.42854, -0.85716)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v", n, expected)
+	}
+}
@@ -0,0 +1,47 @@
+package geometry
+
+import "sort"
+
+// U and V locate the hit on the surface of triangles and are zero otherwise
+type Intersection struct {
+	Object Shape
+	T      float64
+	U      float64
+	V      float64
+	// Time of the ray that made the hit
+	Time float64
+}
+
+func NewIntersection(s Shape, t float64) Intersection {
+	return Intersection{s, t, 0, 0, 0}
+}
+
+func NewIntersectionWithUV(s Shape, t float64, u float64, v float64) Intersection {
+	return Intersection{s, t, u, v, 0}
+}
+
+// Returns sorted intersections
+func Intersections(ts ...Intersection) []Intersection {
+	arr := make([]Intersection, len(ts))
+	copy(arr, ts)
+	return SortIntersections(arr)
+}
+
+func SortIntersections(ts []Intersection) []Intersection {
+	sort.Slice(ts, func(i, j int) bool { return ts[i].T < ts[j].T })
+
+	return ts
+}
+
+// Relies on intersections being sorted
+func Hit(is []Intersection) Intersection {
+	for _, i := range is {
+		if i.T < 0.0 {
+			continue
+		}
+
+		return i
+	}
+
+	return Intersection{}
+}
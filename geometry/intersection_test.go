@@ -0,0 +1,94 @@
+package geometry
+
+import (
+	"reflect"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestIntersectionEncapsulatesTAndObject(t *testing.T) {
+	s := NewSphere()
+	i := NewIntersection(s, 3.5)
+	if !rtmath.FloatEqual(i.T, 3.5) || !reflect.DeepEqual(i.Object, s) {
+		t.Errorf("Expected %v but got %v", NewIntersection(s, 3.5), i)
+	}
+}
+
+func TestAggregateIntersections(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(s, 1)
+	i2 := NewIntersection(s, 2)
+
+	xs := Intersections(i1, i2)
+
+	if len(xs) != 2 || !rtmath.FloatEqual(xs[0].T, 1) || !rtmath.FloatEqual(xs[1].T, 2) {
+		t.Errorf("Expected %v to be %v but it is not", xs, []Intersection{i1, i2})
+	}
+}
+
+func TestHitPositiveT(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(s, 1)
+	i2 := NewIntersection(s, 2)
+	xs := Intersections(i2, i1)
+	i := Hit(xs)
+	if !reflect.DeepEqual(i1, i) {
+		t.Errorf("Expected hit to be %v but it is %v", i1, i)
+	}
+}
+
+func TestHitPositiveAndNegative(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(s, -1)
+	i2 := NewIntersection(s, 1)
+	xs := Intersections(i2, i1)
+	i := Hit(xs)
+	if !reflect.DeepEqual(i2, i) {
+		t.Errorf("Expected hit to be %v but it is %v", i2, i)
+	}
+}
+
+func TestHitNegativeT(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(s, -1)
+	i2 := NewIntersection(s, -2)
+	xs := Intersections(i2, i1)
+	i := Hit(xs)
+	if !reflect.DeepEqual(i, (Intersection{})) {
+		t.Errorf("Expected %v to be blank", i)
+	}
+}
+
+func TestHitLowestNonNegative(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(s, 5)
+	i2 := NewIntersection(s, 7)
+	i3 := NewIntersection(s, -3)
+	i4 := NewIntersection(s, 2)
+	xs := Intersections(i1, i2, i3, i4)
+	i := Hit(xs)
+	if !reflect.DeepEqual(i, i4) {
+		t.Errorf("Expected hit to be %v but it is %v", i4, i)
+	}
+}
+
+func TestIntersectionsIsSorted(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(s, 5)
+	i2 := NewIntersection(s, 7)
+	i3 := NewIntersection(s, -3)
+	i4 := NewIntersection(s, 2)
+	xs := Intersections(i1, i2, i3, i4)
+	if !reflect.DeepEqual(xs[0], i3) || !reflect.DeepEqual(xs[1], i4) || !reflect.DeepEqual(xs[2], i1) || !reflect.DeepEqual(xs[3], i2) {
+		t.Errorf("Expected %v to be sorted", xs)
+	}
+}
+
+func TestIntersectionEncapsulatesUV(t *testing.T) {
+	s := NewTriangle(rtmath.Point(0, 1, 0), rtmath.Point(-1, 0, 0), rtmath.Point(1, 0, 0))
+	i := NewIntersectionWithUV(s, 3.5, 0.2, 0.4)
+	if !rtmath.FloatEqual(i.U, 0.2) || !rtmath.FloatEqual(i.V, 0.4) {
+		t.Errorf("Expected u=0.2 and v=0.4 but got %v", i)
+	}
+}
@@ -0,0 +1,34 @@
+package geometry
+
+import (
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+// Plane is the infinite xz plane in object space
+type Plane struct {
+	BaseShape
+}
+
+func NewPlane() *Plane {
+	return &Plane{newBaseShape()}
+}
+
+func (p *Plane) LocalIntersect(r Ray) ([]Intersection, error) {
+	// Ray parallel to (or coplanar with) the plane never hits it
+	if math.Abs(r.Direction.Y) < rtmath.EPSILON {
+		return []Intersection{}, nil
+	}
+
+	t := -r.Origin.Y / r.Direction.Y
+	return []Intersection{NewIntersection(p, t)}, nil
+}
+
+func (p *Plane) LocalNormalAt(pt rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	return rtmath.Vector(0, 1, 0)
+}
+
+func (p *Plane) LocalBounds() Bounds {
+	return Bounds{rtmath.Point(math.Inf(-1), 0, math.Inf(-1)), rtmath.Point(math.Inf(1), 0, math.Inf(1))}
+}
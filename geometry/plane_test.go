@@ -0,0 +1,78 @@
+package geometry
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestNormalOfPlaneIsConstant(t *testing.T) {
+	p := NewPlane()
+	expected := rtmath.Vector(0, 1, 0)
+	for _, pt := range []rtmath.Tuple{rtmath.Point(0, 0, 0), rtmath.Point(10, 0, -10), rtmath.Point(-5, 0, 150)} {
+		n := p.LocalNormalAt(pt, Intersection{})
+		if !rtmath.TupleEqual(n, expected) {
+			t.Errorf("Expected normal at %v to be %v but got %v", pt, expected, n)
+		}
+	}
+}
+
+func TestIntersectRayParallelToPlane(t *testing.T) {
+	p := NewPlane()
+	r, err := NewRay(rtmath.Point(0, 10, 0), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := p.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 0 {
+		t.Errorf("Expected %v to be empty", xs)
+	}
+}
+
+func TestIntersectCoplanarRay(t *testing.T) {
+	p := NewPlane()
+	r, err := NewRay(rtmath.Point(0, 0, 0), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := p.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 0 {
+		t.Errorf("Expected %v to be empty", xs)
+	}
+}
+
+func TestRayIntersectingPlaneFromAbove(t *testing.T) {
+	p := NewPlane()
+	r, err := NewRay(rtmath.Point(0, 1, 0), rtmath.Vector(0, -1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := p.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 1 || !rtmath.FloatEqual(xs[0].T, 1) || xs[0].Object != p {
+		t.Errorf("Expected a single intersection at t=1 with the plane but got %v", xs)
+	}
+}
+
+func TestRayIntersectingPlaneFromBelow(t *testing.T) {
+	p := NewPlane()
+	r, err := NewRay(rtmath.Point(0, -1, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := p.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 1 || !rtmath.FloatEqual(xs[0].T, 1) || xs[0].Object != p {
+		t.Errorf("Expected a single intersection at t=1 with the plane but got %v", xs)
+	}
+}
@@ -0,0 +1,43 @@
+package geometry
+
+import (
+	"fmt"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+type Ray struct {
+	Origin    rtmath.Tuple
+	Direction rtmath.Tuple
+	// When the ray was cast, moving shapes are intersected where they are
+	// at this time
+	Time float64
+}
+
+func NewRay(origin rtmath.Tuple, direction rtmath.Tuple) (Ray, error) {
+	if !rtmath.IsPoint(origin) {
+		return Ray{}, fmt.Errorf("origin %v must be a point but it is not", origin)
+	}
+	if !rtmath.IsVector(direction) {
+		return Ray{}, fmt.Errorf("direction %v must be a vector but it is not", direction)
+	}
+
+	return Ray{origin, direction, 0}, nil
+}
+
+func RayPosition(ray Ray, t float64) rtmath.Tuple {
+	return rtmath.TupleAdd(ray.Origin, rtmath.TupleScale(ray.Direction, t))
+}
+
+func RayMatrixTransform(r Ray, m rtmath.Matrix) (Ray, error) {
+	origin, err := rtmath.Matrix4x4TupleMultiply(m, r.Origin)
+	if err != nil {
+		return Ray{}, err
+	}
+	dir, err := rtmath.Matrix4x4TupleMultiply(m, r.Direction)
+	if err != nil {
+		return Ray{}, err
+	}
+
+	return Ray{origin, dir, r.Time}, err
+}
@@ -0,0 +1,99 @@
+package geometry
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestCreateRay(t *testing.T) {
+	o := rtmath.Point(1, 2, 3)
+	d := rtmath.Vector(4, 5, 6)
+	r, err := NewRay(o, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !rtmath.TupleEqual(r.Origin, o) {
+		t.Errorf("Expected %v to equal %v", r.Origin, o)
+	}
+	if !rtmath.TupleEqual(r.Direction, d) {
+		t.Errorf("Expected %v to equal %v", r.Direction, d)
+	}
+}
+
+func TestComputePointFromDistance(t *testing.T) {
+	r, err := NewRay(rtmath.Point(2, 3, 4), rtmath.Vector(1, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		t        float64
+		expected rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{0, rtmath.Point(2, 3, 4)},
+		{1, rtmath.Point(3, 3, 4)},
+		{-1, rtmath.Point(1, 3, 4)},
+		{2.5, rtmath.Point(4.5, 3, 4)},
+	}
+
+	for _, c := range cases {
+		out := RayPosition(r, c.t)
+
+		if !rtmath.TupleEqual(out, c.expected) {
+			t.Errorf("Expected position(%v, %f) to be %v but got %v", r, c.t, c.expected, out)
+		}
+	}
+}
+
+func TestTranslateRay(t *testing.T) {
+	r, err := NewRay(rtmath.Point(1, 2, 3), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := rtmath.Translation(3, 4, 5)
+	r2, err := RayMatrixTransform(r, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Ray{rtmath.Point(4, 6, 8), rtmath.Vector(0, 1, 0), 0}
+
+	if r2 != expected {
+		t.Errorf("Expected %v to be %v", r2, expected)
+	}
+}
+
+func TestScaleRay(t *testing.T) {
+	r, err := NewRay(rtmath.Point(1, 2, 3), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := rtmath.Scaling(2, 3, 4)
+	r2, err := RayMatrixTransform(r, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Ray{rtmath.Point(2, 6, 12), rtmath.Vector(0, 3, 0), 0}
+
+	if r2 != expected {
+		t.Errorf("Expected %v to be %v", r2, expected)
+	}
+}
+
+func TestTransformKeepsRayTime(t *testing.T) {
+	r, err := NewRay(rtmath.Point(1, 2, 3), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Time = 0.3
+	r2, err := RayMatrixTransform(r, rtmath.Scaling(2, 3, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r2.Time != 0.3 {
+		t.Errorf("Expected %v to be %v", r2.Time, 0.3)
+	}
+}
@@ -0,0 +1,207 @@
+package geometry
+
+import (
+	"fmt"
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+// Shape is implemented by every primitive that can be placed in a World.
+// LocalIntersect and LocalNormalAt work in object space; Intersect and
+// NormalAt take care of converting to and from world space, including the
+// transforms of any groups the shape belongs to.
+type Shape interface {
+	LocalIntersect(r Ray) ([]Intersection, error)
+	LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple
+	LocalBounds() Bounds
+	GetTransform() rtmath.Matrix
+	GetMotion() Motion
+	GetMaterial() shading.Material
+	SetMaterial(m shading.Material)
+	GetParent() Shape
+	SetParent(p Shape)
+}
+
+// Motion gives the transform of a moving shape at any time, in place of its
+// Transform
+type Motion func(time float64) (rtmath.Matrix, error)
+
+// BaseShape holds the state shared by all shapes and is meant to be embedded
+type BaseShape struct {
+	Transform rtmath.Matrix
+	Material  shading.Material
+	// nil for shapes that stay put
+	Motion Motion
+	parent Shape
+}
+
+func newBaseShape() BaseShape {
+	return BaseShape{rtmath.MatrixConstructIdentity(4), shading.NewMaterial(), nil, nil}
+}
+
+func (b *BaseShape) GetTransform() rtmath.Matrix {
+	return b.Transform
+}
+
+func (b *BaseShape) GetMotion() Motion {
+	return b.Motion
+}
+
+func (b *BaseShape) GetMaterial() shading.Material {
+	return b.Material
+}
+
+func (b *BaseShape) SetMaterial(m shading.Material) {
+	b.Material = m
+}
+
+func (b *BaseShape) GetParent() Shape {
+	return b.parent
+}
+
+func (b *BaseShape) SetParent(p Shape) {
+	b.parent = p
+}
+
+// Moves from start at time 0 to end at time 1, staying put before and after.
+// Each entry of the matrix is interpolated on its own, which is exact for
+// translations and scaling but squashes shapes part way through a rotation,
+// so large turns need a Motion of their own.
+func LinearMotion(start rtmath.Matrix, end rtmath.Matrix) Motion {
+	return func(time float64) (rtmath.Matrix, error) {
+		if start.Height != end.Height || start.Width != end.Width {
+			return rtmath.Matrix{}, fmt.Errorf("can only interpolate matrices of the same size but got %d x %d and %d x %d", start.Height, start.Width, end.Height, end.Width)
+		}
+		time = math.Max(0, math.Min(1, time))
+		values := make([][]float64, start.Height)
+		for i := range values {
+			values[i] = make([]float64, start.Width)
+			for j := range values[i] {
+				values[i][j] = start.Values[i][j] + (end.Values[i][j]-start.Values[i][j])*time
+			}
+		}
+		return rtmath.MatrixConstruct(values), nil
+	}
+}
+
+// Transform of s at time, which is just its Transform unless it is moving
+func TransformAt(s Shape, time float64) (rtmath.Matrix, error) {
+	if s.GetMotion() == nil {
+		return s.GetTransform(), nil
+	}
+	return s.GetMotion()(time)
+}
+
+// Whether s or any group it belongs to is moving
+func IsMoving(s Shape) bool {
+	for ; s != nil; s = s.GetParent() {
+		if s.GetMotion() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func Intersect(s Shape, r Ray) ([]Intersection, error) {
+	m, err := TransformAt(s, r.Time)
+	if err != nil {
+		return []Intersection{}, err
+	}
+	inv, err := rtmath.MatrixInverse(m)
+	if err != nil {
+		return []Intersection{}, err
+	}
+	localRay, err := RayMatrixTransform(r, inv)
+	if err != nil {
+		return []Intersection{}, err
+	}
+
+	xs, err := s.LocalIntersect(localRay)
+	if err != nil {
+		return []Intersection{}, err
+	}
+	return stampTime(xs, r.Time), nil
+}
+
+// Records the time of the ray on its hits, so they can be shaded where the
+// shape was when it was hit
+func stampTime(xs []Intersection, time float64) []Intersection {
+	for i := range xs {
+		xs[i].Time = time
+	}
+	return xs
+}
+
+// Uses the time of the hit to place moving shapes
+func NormalAt(s Shape, p rtmath.Tuple, hit Intersection) (rtmath.Tuple, error) {
+	localPoint, err := WorldToObject(s, p, hit.Time)
+	if err != nil {
+		return rtmath.Tuple{}, err
+	}
+	localNormal := s.LocalNormalAt(localPoint, hit)
+	return NormalToWorld(s, localNormal, hit.Time)
+}
+
+// Converts a world space point to object space at time, going through every
+// parent group
+func WorldToObject(s Shape, p rtmath.Tuple, time float64) (rtmath.Tuple, error) {
+	if s.GetParent() != nil {
+		var err error
+		p, err = WorldToObject(s.GetParent(), p, time)
+		if err != nil {
+			return rtmath.Tuple{}, err
+		}
+	}
+	m, err := TransformAt(s, time)
+	if err != nil {
+		return rtmath.Tuple{}, err
+	}
+	inv, err := rtmath.MatrixInverse(m)
+	if err != nil {
+		return rtmath.Tuple{}, err
+	}
+	return rtmath.Matrix4x4TupleMultiply(inv, p)
+}
+
+// Converts an object space normal to world space at time, going through
+// every parent group
+func NormalToWorld(s Shape, n rtmath.Tuple, time float64) (rtmath.Tuple, error) {
+	m, err := TransformAt(s, time)
+	if err != nil {
+		return rtmath.Tuple{}, err
+	}
+	inv, err := rtmath.MatrixInverse(m)
+	if err != nil {
+		return rtmath.Tuple{}, err
+	}
+	n, err = rtmath.Matrix4x4TupleMultiply(rtmath.MatrixTranspose(inv), n)
+	if err != nil {
+		return rtmath.Tuple{}, err
+	}
+	n.W = 0
+	n = rtmath.VectorNormalize(n)
+
+	if s.GetParent() != nil {
+		return NormalToWorld(s.GetParent(), n, time)
+	}
+	return n, nil
+}
+
+// Transform from the object space of s to world space at time, including
+// every parent group
+func worldTransformAt(s Shape, time float64) (rtmath.Matrix, error) {
+	m, err := TransformAt(s, time)
+	if err != nil {
+		return rtmath.Matrix{}, err
+	}
+	if s.GetParent() == nil {
+		return m, nil
+	}
+	parent, err := worldTransformAt(s.GetParent(), time)
+	if err != nil {
+		return rtmath.Matrix{}, err
+	}
+	return rtmath.Matrix4x4Multiply(parent, m)
+}
@@ -0,0 +1,181 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+type testShape struct {
+	BaseShape
+	savedRay Ray
+}
+
+func newTestShape() *testShape {
+	return &testShape{newBaseShape(), Ray{}}
+}
+
+func (s *testShape) LocalIntersect(r Ray) ([]Intersection, error) {
+	s.savedRay = r
+	return []Intersection{}, nil
+}
+
+func (s *testShape) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	return rtmath.Vector(p.X, p.Y, p.Z)
+}
+
+func (s *testShape) LocalBounds() Bounds {
+	return Bounds{rtmath.Point(-1, -1, -1), rtmath.Point(1, 1, 1)}
+}
+
+func TestShapeDefaultTransform(t *testing.T) {
+	s := newTestShape()
+	if !rtmath.MatrixEqual(s.GetTransform(), rtmath.MatrixConstructIdentity(4)) {
+		t.Errorf("Expected transform to be identity but got %v", s.GetTransform())
+	}
+}
+
+func TestShapeHasNoParent(t *testing.T) {
+	s := newTestShape()
+	if s.GetParent() != nil {
+		t.Errorf("Expected parent to be nil but got %v", s.GetParent())
+	}
+}
+
+func TestIntersectScaledShapeWithRay(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newTestShape()
+	s.Transform = rtmath.Scaling(2, 2, 2)
+	_, err = Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Ray{rtmath.Point(0, 0, -2.5), rtmath.Vector(0, 0, 0.5), 0}
+	if !rtmath.TupleEqual(s.savedRay.Origin, expected.Origin) ||
+		!rtmath.TupleEqual(s.savedRay.Direction, expected.Direction) {
+		t.Errorf("Expected %v to be %v", s.savedRay, expected)
+	}
+}
+
+func TestIntersectTranslatedShapeWithRay(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newTestShape()
+	s.Transform = rtmath.Translation(5, 0, 0)
+	_, err = Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Ray{rtmath.Point(-5, 0, -5), rtmath.Vector(0, 0, 1), 0}
+	if !rtmath.TupleEqual(s.savedRay.Origin, expected.Origin) ||
+		!rtmath.TupleEqual(s.savedRay.Direction, expected.Direction) {
+		t.Errorf("Expected %v to be %v", s.savedRay, expected)
+	}
+}
+
+func TestNormalOnTranslatedShape(t *testing.T) {
+	s := newTestShape()
+	s.Transform = rtmath.Translation(0, 1, 0)
+	n, err := NormalAt(s, rtmath.Point(0, 1.70711, -0.70711), Intersection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0, 0.70711, -0.70711)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v", n, expected)
+	}
+}
+
+func TestNormalOnTransformedShape(t *testing.T) {
+	s := newTestShape()
+	ts, err := rtmath.Matrix4x4Multiply(rtmath.Scaling(1, 0.5, 1), rtmath.RotationZ(math.Pi/5.))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Transform = ts
+	n, err := NormalAt(s, rtmath.Point(0, math.Sqrt2/2, -math.Sqrt2/2), Intersection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0, 0.97014, -0.24254)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v", n, expected)
+	}
+}
+
+func TestLinearMotion(t *testing.T) {
+	motion := LinearMotion(rtmath.Translation(0, 0, 0), rtmath.Translation(4, 2, 0))
+
+	type testCase struct {
+		time     float64
+		expected rtmath.Matrix
+	}
+	cases := []testCase{
+		{0, rtmath.Translation(0, 0, 0)},
+		{0.25, rtmath.Translation(1, 0.5, 0)},
+		{1, rtmath.Translation(4, 2, 0)},
+		// Stays put outside the motion
+		{-1, rtmath.Translation(0, 0, 0)},
+		{2, rtmath.Translation(4, 2, 0)},
+	}
+	for _, c := range cases {
+		res, err := motion(c.time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.MatrixEqual(res, c.expected) {
+			t.Errorf("Expected %v at time %v to be %v", res, c.time, c.expected)
+		}
+	}
+}
+
+func TestIntersectMovingShapeAtTimeOfRay(t *testing.T) {
+	s := NewSphere()
+	s.Motion = LinearMotion(rtmath.Translation(0, 0, 0), rtmath.Translation(4, 0, 0))
+	r, err := NewRay(rtmath.Point(2, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 0 {
+		t.Errorf("Expected the sphere to be out of the way at time 0 but got %v", xs)
+	}
+
+	r.Time = 0.5
+	xs, err = Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 2 || !rtmath.FloatEqual(xs[0].T, 4) || !rtmath.FloatEqual(xs[1].T, 6) {
+		t.Fatalf("Expected hits at 4 and 6 but got %v", xs)
+	}
+	if xs[0].Time != 0.5 || xs[1].Time != 0.5 {
+		t.Errorf("Expected the hits to record time 0.5 but got %v", xs)
+	}
+}
+
+func TestNormalOnMovingShapeUsesTimeOfHit(t *testing.T) {
+	g := NewGroup()
+	g.Motion = LinearMotion(rtmath.Translation(0, 0, 0), rtmath.Translation(0, 4, 0))
+	s := NewSphere()
+	g.AddChild(s)
+
+	n, err := NormalAt(s, rtmath.Point(0, 2, -1), Intersection{Object: s, T: 4, Time: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0, 0, -1)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v", n, expected)
+	}
+}
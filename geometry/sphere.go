@@ -0,0 +1,47 @@
+package geometry
+
+import (
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+type Sphere struct {
+	BaseShape
+	Origin rtmath.Tuple
+	Radius float64
+}
+
+func NewSphere() *Sphere {
+	return &Sphere{newBaseShape(), rtmath.Point(0, 0, 0), 1.}
+}
+
+func (s *Sphere) LocalIntersect(r Ray) ([]Intersection, error) {
+	sphereToRay := rtmath.TupleSubtract(r.Origin, s.Origin)
+
+	a := rtmath.VectorDot(r.Direction, r.Direction)
+	b := rtmath.VectorDot(r.Direction, sphereToRay) * 2
+	c := rtmath.VectorDot(sphereToRay, sphereToRay) - 1
+
+	disc := b*b - 4*a*c
+
+	if disc < 0 {
+		return []Intersection{}, nil
+	}
+
+	t1 := (-b - math.Sqrt(disc)) / (2 * a)
+	t2 := (-b + math.Sqrt(disc)) / (2 * a)
+
+	return []Intersection{NewIntersection(s, t1), NewIntersection(s, t2)}, nil
+}
+
+func (s *Sphere) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	return rtmath.TupleSubtract(p, s.Origin)
+}
+
+func (s *Sphere) LocalBounds() Bounds {
+	return Bounds{
+		rtmath.Point(s.Origin.X-1, s.Origin.Y-1, s.Origin.Z-1),
+		rtmath.Point(s.Origin.X+1, s.Origin.Y+1, s.Origin.Z+1),
+	}
+}
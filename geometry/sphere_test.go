@@ -0,0 +1,248 @@
+package geometry
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+func TestRayIntersectsSphereAtTwoPoints(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(xs) != 2 || xs[0].T != 4.0 || xs[1].T != 6.0 {
+		t.Errorf("Expected %v to be [4.0, 6.0] but it is not", xs)
+	}
+}
+
+func TestRayIntersectsSphereAtTangent(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 1, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(xs) != 2 || xs[0].T != 5.0 || xs[1].T != 5.0 {
+		t.Errorf("Expected %v to be [5.0, 5.0] but it is not", xs)
+	}
+}
+
+func TestRayMissesSphere(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 2, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(xs) != 0 {
+		t.Errorf("Expected %v to be [] but it is not", xs)
+	}
+}
+
+func TestRayOriginatesInsideSphere(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, 0), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(xs) != 2 || xs[0].T != -1.0 || xs[1].T != 1.0 {
+		t.Errorf("Expected %v to be [-1.0, 1.0] but it is not", xs)
+	}
+}
+
+func TestRayIntersectsIsInFrontOfSphere(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, 5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(xs) != 2 || xs[0].T != -6.0 || xs[1].T != -4.0 {
+		t.Errorf("Expected %v to be [-6.0, -4.0] but it is not", xs)
+	}
+}
+
+func TestIntersectSetsObjectOnIntersection(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 2 || !reflect.DeepEqual(xs[0].Object, s) || !reflect.DeepEqual(xs[1].Object, s) {
+		t.Errorf("Object is not set: %v", xs)
+	}
+}
+
+func TestSphereDefaultTransform(t *testing.T) {
+	s := NewSphere()
+
+	if !rtmath.MatrixEqual(s.Transform, rtmath.MatrixConstructIdentity(4)) {
+		t.Errorf("Expected transform to be %v but got %v", s.Transform, rtmath.MatrixConstructIdentity(4))
+	}
+}
+
+func TestChangeSphere(t *testing.T) {
+	s := NewSphere()
+	tr := rtmath.Translation(2, 3, 4)
+
+	s.Transform = tr
+	if !rtmath.MatrixEqual(s.Transform, tr) {
+		t.Errorf("Expected transform to be %v but got %v", s.Transform, tr)
+	}
+}
+
+func TestScaledSphereWithRay(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	s.Transform = rtmath.Scaling(2, 2, 2)
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 2 || !rtmath.FloatEqual(xs[0].T, 3) || !rtmath.FloatEqual(xs[1].T, 7) {
+		t.Errorf("Expected the ts to be [3, 7] but got [%f %f]", xs[0].T, xs[1].T)
+	}
+}
+
+func TestTranslatedSphereWithRay(t *testing.T) {
+	r, err := NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSphere()
+	s.Transform = rtmath.Translation(5, 0, 0)
+	xs, err := Intersect(s, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 0 {
+		t.Errorf("Expected ray to miss sphere but intersects, %v", xs)
+	}
+}
+
+func TestNormalSphere(t *testing.T) {
+	type testCase struct {
+		point  rtmath.Tuple
+		normal rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{rtmath.Point(1, 0, 0), rtmath.Vector(1, 0, 0)},
+		{rtmath.Point(0, 1, 0), rtmath.Vector(0, 1, 0)},
+		{rtmath.Point(0, 0, 1), rtmath.Vector(0, 0, 1)},
+		{rtmath.Point(math.Sqrt(3)/3, math.Sqrt(3)/3, math.Sqrt(3)/3), rtmath.Vector(math.Sqrt(3)/3, math.Sqrt(3)/3, math.Sqrt(3)/3)},
+	}
+
+	for _, v := range cases {
+		s := NewSphere()
+		n, err := NormalAt(s, v.point, Intersection{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.TupleEqual(v.normal, n) {
+			t.Errorf("Expected normal at %v to be %v but got %v", v.point, v.normal, n)
+		}
+	}
+}
+
+func TestNormalIsNormalized(t *testing.T) {
+	s := NewSphere()
+	n, err := NormalAt(s, rtmath.Point(math.Sqrt(3)/3, math.Sqrt(3)/3, math.Sqrt(3)/3), Intersection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.VectorNormalize(n)
+
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v but it is not", n, expected)
+	}
+}
+
+func TestNormalOnTranslatedSphere(t *testing.T) {
+	s := NewSphere()
+	s.Transform = rtmath.Translation(0, 1, 0)
+	n, err := NormalAt(s, rtmath.Point(0, 1.70711, -0.70711), Intersection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0, 0.70711, -0.70711)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v but it is not", n, expected)
+	}
+}
+
+func TestNormalOnTransformedSphere(t *testing.T) {
+	s := NewSphere()
+	ts, err := rtmath.Matrix4x4Multiply(rtmath.Scaling(1, 0.5, 1), rtmath.RotationZ(math.Pi/5.))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Transform = ts
+	n, err := NormalAt(s, rtmath.Point(0, math.Sqrt2/2, -math.Sqrt2/2), Intersection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0, 0.97014, -0.24254)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v but it is not", n, expected)
+	}
+}
+
+func TestSphereHasDefaultMaterial(t *testing.T) {
+	s := NewSphere()
+	m := s.Material
+
+	if !canvas.ColorEqual(m.Color, canvas.NewColor(1, 1, 1)) ||
+		!rtmath.FloatEqual(m.Ambient, 0.1) ||
+		!rtmath.FloatEqual(m.Diffuse, 0.9) ||
+		!rtmath.FloatEqual(m.Specular, 0.9) ||
+		!rtmath.FloatEqual(m.Shininess, 200.) {
+		t.Errorf("Default material is incorrect %v", m)
+	}
+}
+
+func TestReassignMaterial(t *testing.T) {
+	s := NewSphere()
+	m := shading.NewMaterial()
+	m.Ambient = 1
+	s.Material = m
+
+	if !rtmath.FloatEqual(1, s.Material.Ambient) {
+		t.Errorf("Could not reassign sphere's material, have %f instead of %f", s.Material.Ambient, 1.)
+	}
+}
@@ -0,0 +1,87 @@
+package geometry
+
+import (
+	"math"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+type Triangle struct {
+	BaseShape
+	P1     rtmath.Tuple
+	P2     rtmath.Tuple
+	P3     rtmath.Tuple
+	E1     rtmath.Tuple
+	E2     rtmath.Tuple
+	Normal rtmath.Tuple
+}
+
+// SmoothTriangle interpolates the vertex normals N1, N2 and N3 across its face
+type SmoothTriangle struct {
+	Triangle
+	N1 rtmath.Tuple
+	N2 rtmath.Tuple
+	N3 rtmath.Tuple
+}
+
+func NewTriangle(p1 rtmath.Tuple, p2 rtmath.Tuple, p3 rtmath.Tuple) *Triangle {
+	t := &Triangle{BaseShape: newBaseShape(), P1: p1, P2: p2, P3: p3}
+	t.E1 = rtmath.TupleSubtract(p2, p1)
+	t.E2 = rtmath.TupleSubtract(p3, p1)
+	t.Normal = rtmath.VectorNormalize(rtmath.VectorCross(t.E2, t.E1))
+	return t
+}
+
+func NewSmoothTriangle(p1 rtmath.Tuple, p2 rtmath.Tuple, p3 rtmath.Tuple, n1 rtmath.Tuple, n2 rtmath.Tuple, n3 rtmath.Tuple) *SmoothTriangle {
+	return &SmoothTriangle{*NewTriangle(p1, p2, p3), n1, n2, n3}
+}
+
+// Möller–Trumbore intersection, shared by flat and smooth triangles
+func intersectTriangle(s Shape, t *Triangle, r Ray) []Intersection {
+	dirCrossE2 := rtmath.VectorCross(r.Direction, t.E2)
+	det := rtmath.VectorDot(t.E1, dirCrossE2)
+	// Ray parallel to the triangle's plane
+	if math.Abs(det) < rtmath.EPSILON {
+		return []Intersection{}
+	}
+
+	f := 1.0 / det
+	p1ToOrigin := rtmath.TupleSubtract(r.Origin, t.P1)
+	u := f * rtmath.VectorDot(p1ToOrigin, dirCrossE2)
+	if u < 0 || u > 1 {
+		return []Intersection{}
+	}
+
+	originCrossE1 := rtmath.VectorCross(p1ToOrigin, t.E1)
+	v := f * rtmath.VectorDot(r.Direction, originCrossE1)
+	if v < 0 || u+v > 1 {
+		return []Intersection{}
+	}
+
+	tt := f * rtmath.VectorDot(t.E2, originCrossE1)
+	return []Intersection{NewIntersectionWithUV(s, tt, u, v)}
+}
+
+func (t *Triangle) LocalIntersect(r Ray) ([]Intersection, error) {
+	return intersectTriangle(t, t, r), nil
+}
+
+func (t *Triangle) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	return t.Normal
+}
+
+func (t *SmoothTriangle) LocalIntersect(r Ray) ([]Intersection, error) {
+	return intersectTriangle(t, &t.Triangle, r), nil
+}
+
+func (t *SmoothTriangle) LocalNormalAt(p rtmath.Tuple, hit Intersection) rtmath.Tuple {
+	return rtmath.TupleAdd(
+		rtmath.TupleAdd(
+			rtmath.TupleScale(t.N2, hit.U),
+			rtmath.TupleScale(t.N3, hit.V)),
+		rtmath.TupleScale(t.N1, 1-hit.U-hit.V))
+}
+
+func (t *Triangle) LocalBounds() Bounds {
+	return BoundsAddPoint(BoundsAddPoint(BoundsAddPoint(NewBounds(), t.P1), t.P2), t.P3)
+}
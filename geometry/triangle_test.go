@@ -0,0 +1,119 @@
+package geometry
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func testTriangle() *Triangle {
+	return NewTriangle(rtmath.Point(0, 1, 0), rtmath.Point(-1, 0, 0), rtmath.Point(1, 0, 0))
+}
+
+func testSmoothTriangle() *SmoothTriangle {
+	return NewSmoothTriangle(
+		rtmath.Point(0, 1, 0), rtmath.Point(-1, 0, 0), rtmath.Point(1, 0, 0),
+		rtmath.Vector(0, 1, 0), rtmath.Vector(-1, 0, 0), rtmath.Vector(1, 0, 0))
+}
+
+func TestConstructTriangle(t *testing.T) {
+	tri := testTriangle()
+	if !rtmath.TupleEqual(tri.E1, rtmath.Vector(-1, -1, 0)) ||
+		!rtmath.TupleEqual(tri.E2, rtmath.Vector(1, -1, 0)) ||
+		!rtmath.TupleEqual(tri.Normal, rtmath.Vector(0, 0, -1)) {
+		t.Errorf("Triangle was not precomputed correctly, got %v", tri)
+	}
+}
+
+func TestNormalOnTriangle(t *testing.T) {
+	tri := testTriangle()
+	for _, p := range []rtmath.Tuple{rtmath.Point(0, 0.5, 0), rtmath.Point(-0.5, 0.75, 0), rtmath.Point(0.5, 0.25, 0)} {
+		n := tri.LocalNormalAt(p, Intersection{})
+		if !rtmath.TupleEqual(n, tri.Normal) {
+			t.Errorf("Expected normal at %v to be %v but got %v", p, tri.Normal, n)
+		}
+	}
+}
+
+func TestRayMissesTriangle(t *testing.T) {
+	type testCase struct {
+		name      string
+		origin    rtmath.Tuple
+		direction rtmath.Tuple
+	}
+
+	cases := []testCase{
+		{"parallel", rtmath.Point(0, -1, -2), rtmath.Vector(0, 1, 0)},
+		{"p1-p3 edge", rtmath.Point(1, 1, -2), rtmath.Vector(0, 0, 1)},
+		{"p1-p2 edge", rtmath.Point(-1, 1, -2), rtmath.Vector(0, 0, 1)},
+		{"p2-p3 edge", rtmath.Point(0, -1, -2), rtmath.Vector(0, 0, 1)},
+	}
+
+	tri := testTriangle()
+	for _, v := range cases {
+		r, err := NewRay(v.origin, v.direction)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, err := tri.LocalIntersect(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(xs) != 0 {
+			t.Errorf("%s: expected ray to miss but got %v", v.name, xs)
+		}
+	}
+}
+
+func TestRayStrikesTriangle(t *testing.T) {
+	tri := testTriangle()
+	r, err := NewRay(rtmath.Point(0, 0.5, -2), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := tri.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 1 || !rtmath.FloatEqual(xs[0].T, 2) {
+		t.Errorf("Expected a single intersection at t=2 but got %v", xs)
+	}
+}
+
+func TestConstructSmoothTriangle(t *testing.T) {
+	tri := testSmoothTriangle()
+	if !rtmath.TupleEqual(tri.P1, rtmath.Point(0, 1, 0)) ||
+		!rtmath.TupleEqual(tri.N1, rtmath.Vector(0, 1, 0)) ||
+		!rtmath.TupleEqual(tri.N2, rtmath.Vector(-1, 0, 0)) ||
+		!rtmath.TupleEqual(tri.N3, rtmath.Vector(1, 0, 0)) {
+		t.Errorf("Smooth triangle was not constructed correctly, got %v", tri)
+	}
+}
+
+func TestIntersectionWithSmoothTriangleStoresUV(t *testing.T) {
+	tri := testSmoothTriangle()
+	r, err := NewRay(rtmath.Point(-0.2, 0.3, -2), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := tri.LocalIntersect(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 1 || xs[0].Object != tri || !rtmath.FloatEqual(xs[0].U, 0.45) || !rtmath.FloatEqual(xs[0].V, 0.25) {
+		t.Errorf("Expected u=0.45, v=0.25 on the smooth triangle but got %v", xs)
+	}
+}
+
+func TestSmoothTriangleInterpolatesNormal(t *testing.T) {
+	tri := testSmoothTriangle()
+	i := NewIntersectionWithUV(tri, 1, 0.45, 0.25)
+	n, err := NormalAt(tri, rtmath.Point(0, 0, 0), i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(-0.5547, 0.83205, 0)
+	if !rtmath.TupleEqual(n, expected) {
+		t.Errorf("Expected %v to be %v", n, expected)
+	}
+}
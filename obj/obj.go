@@ -0,0 +1,244 @@
+package obj
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+)
+
+// Statements that are valid OBJ but have nothing to do with geometry we can
+// render, like object names, smoothing groups and materials
+var ignoredStatements = map[string]bool{
+	"o":      true,
+	"s":      true,
+	"mg":     true,
+	"usemtl": true,
+	"mtllib": true,
+	"l":      true,
+	"p":      true,
+	"vp":     true,
+}
+
+// SkippedLine is a line of the file that did not contribute any geometry
+type SkippedLine struct {
+	Line   int
+	Text   string
+	Reason string
+}
+
+// Parser holds everything read from a Wavefront OBJ file. Vertices, Normals
+// and TextureCoords are 1-indexed like in the file, so index 0 is unused.
+type Parser struct {
+	Vertices      []rtmath.Tuple
+	Normals       []rtmath.Tuple
+	TextureCoords []rtmath.Tuple
+	DefaultGroup  *geometry.Group
+	Groups        map[string]*geometry.Group
+	GroupNames    []string
+	// Lines that are malformed or not OBJ at all
+	Skipped []SkippedLine
+	// Valid statements the parser has no use for
+	Ignored []SkippedLine
+}
+
+// Index of a face vertex into Vertices, TextureCoords and Normals, 0 if absent
+type faceVertex struct {
+	v  int
+	vt int
+	vn int
+}
+
+func ParseFile(path string) (*Parser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+func Parse(r io.Reader) (*Parser, error) {
+	p := &Parser{
+		Vertices:      []rtmath.Tuple{{}},
+		Normals:       []rtmath.Tuple{{}},
+		TextureCoords: []rtmath.Tuple{{}},
+		DefaultGroup:  geometry.NewGroup(),
+		Groups:        map[string]*geometry.Group{},
+		GroupNames:    []string{},
+		Skipped:       []SkippedLine{},
+		Ignored:       []SkippedLine{},
+	}
+	current := p.DefaultGroup
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		text := scanner.Text()
+		// Comments run from # to the end of the line
+		statement, _, _ := strings.Cut(text, "#")
+		fields := strings.Fields(statement)
+		if len(fields) == 0 {
+			continue
+		}
+		if ignoredStatements[fields[0]] {
+			p.Ignored = append(p.Ignored, SkippedLine{lineNo, text, fmt.Sprintf("ignored statement %q", fields[0])})
+			continue
+		}
+
+		var err error
+		switch fields[0] {
+		case "v":
+			var t rtmath.Tuple
+			t, err = parseTuple(fields[1:], 3)
+			if err == nil {
+				p.Vertices = append(p.Vertices, rtmath.Point(t.X, t.Y, t.Z))
+			}
+		case "vn":
+			var t rtmath.Tuple
+			t, err = parseTuple(fields[1:], 3)
+			if err == nil {
+				p.Normals = append(p.Normals, rtmath.Vector(t.X, t.Y, t.Z))
+			}
+		case "vt":
+			var t rtmath.Tuple
+			t, err = parseTuple(fields[1:], 1)
+			if err == nil {
+				p.TextureCoords = append(p.TextureCoords, t)
+			}
+		case "f":
+			err = p.parseFace(fields[1:], current)
+		case "g":
+			if len(fields) < 2 {
+				err = fmt.Errorf("group has no name")
+				break
+			}
+			name := strings.Join(fields[1:], " ")
+			g, ok := p.Groups[name]
+			if !ok {
+				g = geometry.NewGroup()
+				p.Groups[name] = g
+				p.GroupNames = append(p.GroupNames, name)
+			}
+			current = g
+		default:
+			err = fmt.Errorf("unsupported statement %q", fields[0])
+		}
+
+		if err != nil {
+			p.Skipped = append(p.Skipped, SkippedLine{lineNo, text, err.Error()})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Parses at least min and at most 3 coordinates, missing ones are 0
+func parseTuple(fields []string, min int) (rtmath.Tuple, error) {
+	if len(fields) < min {
+		return rtmath.Tuple{}, fmt.Errorf("expected at least %d coordinates but got %d", min, len(fields))
+	}
+	coords := [3]float64{}
+	// Anything past the third coordinate (e.g. the optional w) is ignored
+	for i := 0; i < len(fields) && i < 3; i++ {
+		f, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return rtmath.Tuple{}, fmt.Errorf("invalid coordinate %q", fields[i])
+		}
+		coords[i] = f
+	}
+
+	return rtmath.Tuple{X: coords[0], Y: coords[1], Z: coords[2]}, nil
+}
+
+// Resolves a 1-based (or negative, relative to the end) OBJ index
+func resolveIndex(s string, count int) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid index %q", s)
+	}
+	if i < 0 {
+		i = count + i
+	}
+	if i <= 0 || i >= count {
+		return 0, fmt.Errorf("index %s out of range", s)
+	}
+	return i, nil
+}
+
+// Parses v, v/vt, v//vn and v/vt/vn
+func (p *Parser) parseFaceVertex(s string) (faceVertex, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) > 3 {
+		return faceVertex{}, fmt.Errorf("invalid face vertex %q", s)
+	}
+
+	fv := faceVertex{}
+	var err error
+	fv.v, err = resolveIndex(parts[0], len(p.Vertices))
+	if err != nil {
+		return faceVertex{}, err
+	}
+	if len(parts) > 1 && parts[1] != "" {
+		fv.vt, err = resolveIndex(parts[1], len(p.TextureCoords))
+		if err != nil {
+			return faceVertex{}, err
+		}
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		fv.vn, err = resolveIndex(parts[2], len(p.Normals))
+		if err != nil {
+			return faceVertex{}, err
+		}
+	}
+	return fv, nil
+}
+
+// Adds a face to g, splitting polygons into a fan of triangles
+func (p *Parser) parseFace(fields []string, g *geometry.Group) error {
+	if len(fields) < 3 {
+		return fmt.Errorf("face needs at least 3 vertices but got %d", len(fields))
+	}
+
+	fvs := make([]faceVertex, len(fields))
+	for i, f := range fields {
+		fv, err := p.parseFaceVertex(f)
+		if err != nil {
+			return err
+		}
+		fvs[i] = fv
+	}
+
+	for i := 1; i < len(fvs)-1; i++ {
+		a, b, c := fvs[0], fvs[i], fvs[i+1]
+		p1, p2, p3 := p.Vertices[a.v], p.Vertices[b.v], p.Vertices[c.v]
+		if a.vn != 0 && b.vn != 0 && c.vn != 0 {
+			g.AddChild(geometry.NewSmoothTriangle(p1, p2, p3, p.Normals[a.vn], p.Normals[b.vn], p.Normals[c.vn]))
+		} else {
+			g.AddChild(geometry.NewTriangle(p1, p2, p3))
+		}
+	}
+	return nil
+}
+
+// Returns a single group holding the default group and every named group
+func ToGroup(p *Parser) *geometry.Group {
+	g := geometry.NewGroup()
+	if len(p.DefaultGroup.Children) > 0 {
+		g.AddChild(p.DefaultGroup)
+	}
+	for _, name := range p.GroupNames {
+		g.AddChild(p.Groups[name])
+	}
+	return g
+}
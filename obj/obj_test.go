@@ -0,0 +1,268 @@
+package obj
+
+import (
+	"strings"
+	"testing"
+
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestIgnoreUnrecognizedLines(t *testing.T) {
+	gibberish := `There was a young lady named Bright
+who traveled much faster than light.
+She set out one day
+in a relative way,
+and came back the previous night.`
+	p, err := Parse(strings.NewReader(gibberish))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Skipped) != 5 {
+		t.Fatalf("Expected 5 skipped lines but got %v", p.Skipped)
+	}
+	for i, s := range p.Skipped {
+		if s.Line != i+1 {
+			t.Errorf("Expected skipped line %d to be numbered %d but got %d", i, i+1, s.Line)
+		}
+	}
+}
+
+func TestReportMalformedLines(t *testing.T) {
+	file := `v -1 1 0
+v -1 x 0
+
+f 1 2 5
+vn 0 0
+`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []int{2, 4, 5}
+	if len(p.Skipped) != len(expected) {
+		t.Fatalf("Expected lines %v to be skipped but got %v", expected, p.Skipped)
+	}
+	for i, s := range p.Skipped {
+		if s.Line != expected[i] {
+			t.Errorf("Expected line %d to be skipped but got %v", expected[i], s)
+		}
+	}
+}
+
+func TestCommentsAndUnusedStatementsAreNotReported(t *testing.T) {
+	file := `# Exported by a modeller
+mtllib scene.mtl
+o Triangle
+v -1 1 0 # top left
+v -1 0 0
+v 1 0 0
+usemtl red
+s off
+f 1 2 3
+`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Skipped) != 0 {
+		t.Errorf("Expected no skipped lines but got %v", p.Skipped)
+	}
+	expected := []int{2, 3, 7, 8}
+	if len(p.Ignored) != len(expected) {
+		t.Fatalf("Expected lines %v to be ignored but got %v", expected, p.Ignored)
+	}
+	for i, s := range p.Ignored {
+		if s.Line != expected[i] {
+			t.Errorf("Expected line %d to be ignored but got %v", expected[i], s)
+		}
+	}
+	if len(p.Vertices) != 4 || len(p.DefaultGroup.Children) != 1 {
+		t.Errorf("Expected 3 vertices and a triangle but got %v and %v", p.Vertices[1:], p.DefaultGroup.Children)
+	}
+}
+
+func TestVertexRecords(t *testing.T) {
+	file := `v -1 1 0
+v -1.0000 0.5000 0.0000
+v 1 0 0
+v 1 1 0`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []rtmath.Tuple{
+		rtmath.Point(-1, 1, 0),
+		rtmath.Point(-1, 0.5, 0),
+		rtmath.Point(1, 0, 0),
+		rtmath.Point(1, 1, 0),
+	}
+	for i, e := range expected {
+		if !rtmath.TupleEqual(p.Vertices[i+1], e) {
+			t.Errorf("Expected vertex %d to be %v but got %v", i+1, e, p.Vertices[i+1])
+		}
+	}
+}
+
+func TestParseTriangleFaces(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+
+f 1 2 3
+f 1 3 4`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := p.DefaultGroup
+	if len(g.Children) != 2 {
+		t.Fatalf("Expected 2 triangles but got %v", g.Children)
+	}
+	t1 := g.Children[0].(*geometry.Triangle)
+	t2 := g.Children[1].(*geometry.Triangle)
+	if !rtmath.TupleEqual(t1.P1, p.Vertices[1]) ||
+		!rtmath.TupleEqual(t1.P2, p.Vertices[2]) ||
+		!rtmath.TupleEqual(t1.P3, p.Vertices[3]) ||
+		!rtmath.TupleEqual(t2.P1, p.Vertices[1]) ||
+		!rtmath.TupleEqual(t2.P2, p.Vertices[3]) ||
+		!rtmath.TupleEqual(t2.P3, p.Vertices[4]) {
+		t.Errorf("Triangles do not use the right vertices: %v %v", t1, t2)
+	}
+}
+
+func TestTriangulatePolygons(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+v 0 2 0
+
+f 1 2 3 4 5`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := p.DefaultGroup
+	if len(g.Children) != 3 {
+		t.Fatalf("Expected 3 triangles but got %v", g.Children)
+	}
+	for i, c := range g.Children {
+		tri := c.(*geometry.Triangle)
+		if !rtmath.TupleEqual(tri.P1, p.Vertices[1]) ||
+			!rtmath.TupleEqual(tri.P2, p.Vertices[i+2]) ||
+			!rtmath.TupleEqual(tri.P3, p.Vertices[i+3]) {
+			t.Errorf("Triangle %d does not use the right vertices: %v", i, tri)
+		}
+	}
+}
+
+func TestTrianglesInGroups(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+
+g FirstGroup
+f 1 2 3
+g SecondGroup
+f 1 3 4`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g1 := p.Groups["FirstGroup"]
+	g2 := p.Groups["SecondGroup"]
+	if g1 == nil || g2 == nil || len(g1.Children) != 1 || len(g2.Children) != 1 {
+		t.Fatalf("Expected one triangle in each named group but got %v", p.Groups)
+	}
+	t1 := g1.Children[0].(*geometry.Triangle)
+	t2 := g2.Children[0].(*geometry.Triangle)
+	if !rtmath.TupleEqual(t1.P3, p.Vertices[3]) || !rtmath.TupleEqual(t2.P3, p.Vertices[4]) {
+		t.Errorf("Triangles do not use the right vertices: %v %v", t1, t2)
+	}
+}
+
+func TestConvertToGroup(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+
+g FirstGroup
+f 1 2 3
+g SecondGroup
+f 1 3 4`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := ToGroup(p)
+	if len(g.Children) != 2 || g.Children[0] != p.Groups["FirstGroup"] || g.Children[1] != p.Groups["SecondGroup"] {
+		t.Errorf("Expected the named groups to be children but got %v", g.Children)
+	}
+}
+
+func TestVertexNormalRecords(t *testing.T) {
+	file := `vn 0 0 1
+vn 0.707 0 -0.707
+vn 1 2 3`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []rtmath.Tuple{
+		rtmath.Vector(0, 0, 1),
+		rtmath.Vector(0.707, 0, -0.707),
+		rtmath.Vector(1, 2, 3),
+	}
+	for i, e := range expected {
+		if !rtmath.TupleEqual(p.Normals[i+1], e) {
+			t.Errorf("Expected normal %d to be %v but got %v", i+1, e, p.Normals[i+1])
+		}
+	}
+}
+
+func TestFacesWithNormals(t *testing.T) {
+	file := `v 0 1 0
+v -1 0 0
+v 1 0 0
+
+vt 0 0
+vt 0.5 1
+vt 1 0
+
+vn -1 0 0
+vn 1 0 0
+vn 0 1 0
+
+f 1//3 2//1 3//2
+f 1/1/3 2/2/1 3/3/2
+f -3/-3/-1 -2/-2/-3 -1/-1/-2`
+	p, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Skipped) != 0 {
+		t.Fatalf("Expected no skipped lines but got %v", p.Skipped)
+	}
+	g := p.DefaultGroup
+	if len(g.Children) != 3 {
+		t.Fatalf("Expected 3 smooth triangles but got %v", g.Children)
+	}
+	for i, c := range g.Children {
+		tri, ok := c.(*geometry.SmoothTriangle)
+		if !ok {
+			t.Fatalf("Expected triangle %d to be smooth but got %v", i, c)
+		}
+		if !rtmath.TupleEqual(tri.P1, p.Vertices[1]) ||
+			!rtmath.TupleEqual(tri.P2, p.Vertices[2]) ||
+			!rtmath.TupleEqual(tri.P3, p.Vertices[3]) ||
+			!rtmath.TupleEqual(tri.N1, p.Normals[3]) ||
+			!rtmath.TupleEqual(tri.N2, p.Normals[1]) ||
+			!rtmath.TupleEqual(tri.N3, p.Normals[2]) {
+			t.Errorf("Smooth triangle %d does not use the right vertices: %v", i, tri)
+		}
+	}
+}
@@ -1,8 +1,6 @@
-package main
+package rtmath
 
-import (
-	"fmt"
-)
+import "fmt"
 
 type Matrix struct {
 	Values [][]float64
@@ -10,18 +8,18 @@ type Matrix struct {
 	Width  int64
 }
 
-func matrixConstruct(values [][]float64) Matrix {
+func MatrixConstruct(values [][]float64) Matrix {
 	return Matrix{values, int64(len(values)), int64(len(values[0]))}
 }
 
-func matrixEqual(a Matrix, b Matrix) bool {
+func MatrixEqual(a Matrix, b Matrix) bool {
 	if a.Height != b.Height || a.Width != b.Width {
 		return false
 	}
 
 	for i := range a.Values {
 		for j := range a.Values[i] {
-			if !floatEqual(a.Values[i][j], b.Values[i][j]) {
+			if !FloatEqual(a.Values[i][j], b.Values[i][j]) {
 				return false
 			}
 		}
@@ -29,7 +27,7 @@ func matrixEqual(a Matrix, b Matrix) bool {
 	return true
 }
 
-func matrix4x4Multiply(aM Matrix, bM Matrix) (Matrix, error) {
+func Matrix4x4Multiply(aM Matrix, bM Matrix) (Matrix, error) {
 	if aM.Height != 4 || aM.Width != 4 || bM.Height != 4 || bM.Width != 4 {
 		return Matrix{}, fmt.Errorf("can only multiply 4 x 4 matrices but got %d x %d * %d x %d", aM.Height, aM.Width, bM.Height, bM.Width)
 	}
@@ -49,7 +47,7 @@ func matrix4x4Multiply(aM Matrix, bM Matrix) (Matrix, error) {
 	return Matrix{out, 4, 4}, nil
 }
 
-func matrix4x4TupleMultiply(a Matrix, t Tuple) (Tuple, error) {
+func Matrix4x4TupleMultiply(a Matrix, t Tuple) (Tuple, error) {
 	if a.Height != 4 || a.Width != 4 {
 		return Tuple{}, fmt.Errorf("can only multiply 4 x 4 matrix but got %d x %d", a.Height, a.Width)
 	}
@@ -62,7 +60,7 @@ func matrix4x4TupleMultiply(a Matrix, t Tuple) (Tuple, error) {
 	return Tuple{out[0], out[1], out[2], out[3]}, nil
 }
 
-func matrixConstructIdentity(n int64) Matrix {
+func MatrixConstructIdentity(n int64) Matrix {
 	a := make([][]float64, n)
 
 	for i := int64(0); i < n; i++ {
@@ -78,7 +76,7 @@ func matrixConstructIdentity(n int64) Matrix {
 	return Matrix{a, n, n}
 }
 
-func matrixTranspose(a Matrix) Matrix {
+func MatrixTranspose(a Matrix) Matrix {
 	vals := make([][]float64, a.Width)
 	for i := range vals {
 		vals[i] = make([]float64, a.Height)
@@ -90,7 +88,7 @@ func matrixTranspose(a Matrix) Matrix {
 	return Matrix{vals, a.Width, a.Height}
 }
 
-func matrix2x2Determinant(a Matrix) (float64, error) {
+func Matrix2x2Determinant(a Matrix) (float64, error) {
 	if a.Height != 2 || a.Width != 2 {
 		return 0, fmt.Errorf("can only compute for 2 x 2 matrix but got %d x %d", a.Height, a.Width)
 	}
@@ -99,7 +97,7 @@ func matrix2x2Determinant(a Matrix) (float64, error) {
 	return vals[0][0]*vals[1][1] - vals[0][1]*vals[1][0], nil
 }
 
-func matrixSubmatrix(a Matrix, row int64, col int64) (Matrix, error) {
+func MatrixSubmatrix(a Matrix, row int64, col int64) (Matrix, error) {
 	if a.Height == 1 || a.Width == 1 {
 		return Matrix{[][]float64{}, 0, 0}, nil
 	}
@@ -138,17 +136,17 @@ func matrixSubmatrix(a Matrix, row int64, col int64) (Matrix, error) {
 	return Matrix{out, h, w}, nil
 }
 
-func matrixMinor(a Matrix, row int64, col int64) (float64, error) {
-	minor, err := matrixSubmatrix(a, row, col)
+func MatrixMinor(a Matrix, row int64, col int64) (float64, error) {
+	minor, err := MatrixSubmatrix(a, row, col)
 	if err != nil {
 		return 0, err
 	}
 
-	return matrixDeterminant(minor)
+	return MatrixDeterminant(minor)
 }
 
-func matrixCofactor(a Matrix, row int64, col int64) (float64, error) {
-	minor, err := matrixMinor(a, row, col)
+func MatrixCofactor(a Matrix, row int64, col int64) (float64, error) {
+	minor, err := MatrixMinor(a, row, col)
 	if err != nil {
 		return 0, err
 	}
@@ -160,14 +158,14 @@ func matrixCofactor(a Matrix, row int64, col int64) (float64, error) {
 	return minor, nil
 }
 
-func matrixDeterminant(a Matrix) (float64, error) {
+func MatrixDeterminant(a Matrix) (float64, error) {
 	if a.Height == 2 && a.Width == 2 {
-		return matrix2x2Determinant(a)
+		return Matrix2x2Determinant(a)
 	}
 
 	det := 0.0
 	for col := range a.Values {
-		cof, err := matrixCofactor(a, 0, int64(col))
+		cof, err := MatrixCofactor(a, 0, int64(col))
 		if err != nil {
 			return 0, err
 		}
@@ -177,29 +175,29 @@ func matrixDeterminant(a Matrix) (float64, error) {
 	return det, nil
 }
 
-func matrixIsInvertible(a Matrix) (bool, error) {
-	det, err := matrixDeterminant(a)
+func MatrixIsInvertible(a Matrix) (bool, error) {
+	det, err := MatrixDeterminant(a)
 	if err != nil {
 		return false, err
 	}
 	return det != 0, nil
 }
 
-func matrixInverse(a Matrix) (Matrix, error) {
+func MatrixInverse(a Matrix) (Matrix, error) {
 	cofs := make([][]float64, a.Height)
-	det, err := matrixDeterminant(a)
+	det, err := MatrixDeterminant(a)
 	if err != nil {
 		return Matrix{}, err
 	}
 	for i := range cofs {
 		cofs[i] = make([]float64, a.Width)
 		for j := range cofs[i] {
-			cof, err := matrixCofactor(a, int64(j), int64(i))
+			cof, err := MatrixCofactor(a, int64(j), int64(i))
 			if err != nil {
 				return Matrix{}, err
 			}
 			cofs[i][j] = cof / det
 		}
 	}
-	return matrixConstruct(cofs), nil
+	return MatrixConstruct(cofs), nil
 }
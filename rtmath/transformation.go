@@ -1,9 +1,9 @@
-package main
+package rtmath
 
 import "math"
 
-func translation(x float64, y float64, z float64) Matrix {
-	id := matrixConstructIdentity(4)
+func Translation(x float64, y float64, z float64) Matrix {
+	id := MatrixConstructIdentity(4)
 	id.Values[0][3] = x
 	id.Values[1][3] = y
 	id.Values[2][3] = z
@@ -11,8 +11,8 @@ func translation(x float64, y float64, z float64) Matrix {
 	return id
 }
 
-func scaling(x float64, y float64, z float64) Matrix {
-	id := matrixConstructIdentity(4)
+func Scaling(x float64, y float64, z float64) Matrix {
+	id := MatrixConstructIdentity(4)
 	id.Values[0][0] = x
 	id.Values[1][1] = y
 	id.Values[2][2] = z
@@ -20,8 +20,8 @@ func scaling(x float64, y float64, z float64) Matrix {
 	return id
 }
 
-func rotationX(rads float64) Matrix {
-	id := matrixConstructIdentity(4)
+func RotationX(rads float64) Matrix {
+	id := MatrixConstructIdentity(4)
 
 	id.Values[1][1] = math.Cos(rads)
 	id.Values[1][2] = -math.Sin(rads)
@@ -31,8 +31,8 @@ func rotationX(rads float64) Matrix {
 	return id
 }
 
-func rotationY(rads float64) Matrix {
-	id := matrixConstructIdentity(4)
+func RotationY(rads float64) Matrix {
+	id := MatrixConstructIdentity(4)
 
 	id.Values[0][0] = math.Cos(rads)
 	id.Values[2][0] = -math.Sin(rads)
@@ -42,8 +42,8 @@ func rotationY(rads float64) Matrix {
 	return id
 }
 
-func rotationZ(rads float64) Matrix {
-	id := matrixConstructIdentity(4)
+func RotationZ(rads float64) Matrix {
+	id := MatrixConstructIdentity(4)
 
 	id.Values[0][0] = math.Cos(rads)
 	id.Values[0][1] = -math.Sin(rads)
@@ -53,8 +53,8 @@ func rotationZ(rads float64) Matrix {
 	return id
 }
 
-func shearing(xy float64, xz float64, yx float64, yz float64, zx float64, zy float64) Matrix {
-	m := matrixConstructIdentity(4)
+func Shearing(xy float64, xz float64, yx float64, yz float64, zx float64, zy float64) Matrix {
+	m := MatrixConstructIdentity(4)
 	id := m.Values
 
 	id[0][1] = xy
@@ -67,12 +67,12 @@ func shearing(xy float64, xz float64, yx float64, yz float64, zx float64, zy flo
 	return m
 }
 
-func transformation(transforms ...Matrix) (Matrix, error) {
-	out := matrixConstructIdentity(4)
+func Transformation(transforms ...Matrix) (Matrix, error) {
+	out := MatrixConstructIdentity(4)
 
 	for _, t := range transforms {
 		var err error
-		out, err = matrix4x4Multiply(t, out)
+		out, err = Matrix4x4Multiply(t, out)
 		if err != nil {
 			return Matrix{}, err
 		}
@@ -0,0 +1,323 @@
+package rtmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMultiplyByTranslationMatrix(t *testing.T) {
+	transform := Translation(5, -3, 2)
+	p := Point(-3, 4, 5)
+
+	translated, err := Matrix4x4TupleMultiply(transform, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !TupleEqual(translated, Point(2, 1, 7)) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, p, Point(2, 1, 7), translated)
+	}
+}
+
+func TestMultiplyByInverseOfTranslationMatrix(t *testing.T) {
+	transform, err := MatrixInverse(Translation(5, -3, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := Point(-3, 4, 5)
+
+	translated, err := Matrix4x4TupleMultiply(transform, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !TupleEqual(translated, Point(-8, 7, 3)) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, p, Point(-8, 7, 3), translated)
+	}
+}
+
+func TestTranslationDoesNotAffectVectors(t *testing.T) {
+	transform := Translation(5, -3, 2)
+	v := Vector(-3, 4, 5)
+
+	translated, err := Matrix4x4TupleMultiply(transform, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !TupleEqual(translated, v) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, v, v, translated)
+	}
+}
+
+func TestScalingPoint(t *testing.T) {
+	transform := Scaling(2, 3, 4)
+	p := Point(-4, 6, 8)
+
+	translated, err := Matrix4x4TupleMultiply(transform, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Point(-8, 18, 32)
+
+	if !TupleEqual(translated, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, p, expected, translated)
+	}
+}
+
+func TestScalingVector(t *testing.T) {
+	transform := Scaling(2, 3, 4)
+	v := Vector(-4, 6, 8)
+
+	translated, err := Matrix4x4TupleMultiply(transform, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Vector(-8, 18, 32)
+
+	if !TupleEqual(translated, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, v, expected, translated)
+	}
+}
+
+func TestScalingInverse(t *testing.T) {
+	init := Scaling(2, 3, 4)
+	transform, err := MatrixInverse(init)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := Vector(-4, 6, 8)
+
+	translated, err := Matrix4x4TupleMultiply(transform, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Vector(-2, 2, 2)
+
+	if !TupleEqual(translated, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, v, expected, translated)
+	}
+}
+
+func TestReflectingIsScalingByNegative(t *testing.T) {
+	transform := Scaling(-1, 1, 1)
+	p := Point(2, 3, 4)
+
+	translated, err := Matrix4x4TupleMultiply(transform, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Point(-2, 3, 4)
+
+	if !TupleEqual(translated, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, p, expected, translated)
+	}
+}
+
+func TestRotateAroundX(t *testing.T) {
+	halfQuarter := RotationX(math.Pi / 4)
+	fullQuarter := RotationX(math.Pi / 2)
+	p := Point(0, 1, 0)
+
+	translatedHalf, err := Matrix4x4TupleMultiply(halfQuarter, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedHalf := Point(0, math.Sqrt(2)/2, math.Sqrt(2)/2)
+
+	if !TupleEqual(translatedHalf, expectedHalf) {
+		t.Errorf("Expected %v * %v to be %v but got %v", halfQuarter, p, expectedHalf, translatedHalf)
+	}
+
+	translatedFull, err := Matrix4x4TupleMultiply(fullQuarter, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFull := Point(0, 0, 1)
+
+	if !TupleEqual(translatedFull, expectedFull) {
+		t.Errorf("Expected %v * %v to be %v but got %v", fullQuarter, p, expectedFull, translatedFull)
+	}
+}
+
+func TestInverseXRotation(t *testing.T) {
+	init := RotationX(math.Pi / 4)
+	transform, err := MatrixInverse(init)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := Point(0, 1, 0)
+
+	translated, err := Matrix4x4TupleMultiply(transform, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Point(0, math.Sqrt(2)/2, -math.Sqrt(2)/2)
+
+	if !TupleEqual(translated, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", transform, p, expected, translated)
+	}
+}
+
+func TestRotateAroundY(t *testing.T) {
+	halfQuarter := RotationY(math.Pi / 4)
+	fullQuarter := RotationY(math.Pi / 2)
+	p := Point(0, 0, 1)
+
+	translatedHalf, err := Matrix4x4TupleMultiply(halfQuarter, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedHalf := Point(math.Sqrt(2)/2, 0, math.Sqrt(2)/2)
+
+	if !TupleEqual(translatedHalf, expectedHalf) {
+		t.Errorf("Expected %v * %v to be %v but got %v", halfQuarter, p, expectedHalf, translatedHalf)
+	}
+
+	translatedFull, err := Matrix4x4TupleMultiply(fullQuarter, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFull := Point(1, 0, 0)
+
+	if !TupleEqual(translatedFull, expectedFull) {
+		t.Errorf("Expected %v * %v to be %v but got %v", fullQuarter, p, expectedFull, translatedFull)
+	}
+}
+
+func TestRotateAroundZ(t *testing.T) {
+	halfQuarter := RotationZ(math.Pi / 4)
+	fullQuarter := RotationZ(math.Pi / 2)
+	p := Point(0, 1, 0)
+
+	translatedHalf, err := Matrix4x4TupleMultiply(halfQuarter, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedHalf := Point(-math.Sqrt(2)/2, math.Sqrt(2)/2, 0)
+
+	if !TupleEqual(translatedHalf, expectedHalf) {
+		t.Errorf("Expected %v * %v to be %v but got %v", halfQuarter, p, expectedHalf, translatedHalf)
+	}
+
+	translatedFull, err := Matrix4x4TupleMultiply(fullQuarter, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFull := Point(-1, 0, 0)
+
+	if !TupleEqual(translatedFull, expectedFull) {
+		t.Errorf("Expected %v * %v to be %v but got %v", fullQuarter, p, expectedFull, translatedFull)
+	}
+}
+
+func TestShearing(t *testing.T) {
+	type testCase struct {
+		shearing Matrix
+		p        Tuple
+		expected Tuple
+	}
+
+	cases := []testCase{
+		{Shearing(1, 0, 0, 0, 0, 0), Point(2, 3, 4), Point(5, 3, 4)},
+		{Shearing(0, 1, 0, 0, 0, 0), Point(2, 3, 4), Point(6, 3, 4)},
+		{Shearing(0, 0, 1, 0, 0, 0), Point(2, 3, 4), Point(2, 5, 4)},
+		{Shearing(0, 0, 0, 1, 0, 0), Point(2, 3, 4), Point(2, 7, 4)},
+		{Shearing(0, 0, 0, 0, 1, 0), Point(2, 3, 4), Point(2, 3, 6)},
+		{Shearing(0, 0, 0, 0, 0, 1), Point(2, 3, 4), Point(2, 3, 7)},
+	}
+
+	for _, c := range cases {
+		res, err := Matrix4x4TupleMultiply(c.shearing, c.p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !TupleEqual(res, c.expected) {
+			t.Errorf("Expected %v * %v to be %v but got %v", c.shearing, c.p, c.expected, res)
+		}
+	}
+}
+
+func TestMultipleTransformationsInSequence(t *testing.T) {
+	p := Point(1, 0, 1)
+	A := RotationX(math.Pi / 2)
+	B := Scaling(5, 5, 5)
+	C := Translation(10, 5, 7)
+
+	p2, err := Matrix4x4TupleMultiply(A, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Point(1, -1, 0)
+
+	if !TupleEqual(p2, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", A, p, expected, p2)
+	}
+
+	p3, err := Matrix4x4TupleMultiply(B, p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = Point(5, -5, 0)
+
+	if !TupleEqual(p3, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", B, p2, expected, p3)
+	}
+
+	p4, err := Matrix4x4TupleMultiply(C, p3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = Point(15, 0, 7)
+
+	if !TupleEqual(p4, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", C, p3, expected, p4)
+	}
+}
+
+func TestMultipleTransformationsChained(t *testing.T) {
+	p := Point(1, 0, 1)
+	A := RotationX(math.Pi / 2)
+	B := Scaling(5, 5, 5)
+	C := Translation(10, 5, 7)
+	expected := Point(15, 0, 7)
+
+	ABC1, err := Matrix4x4Multiply(B, A)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ABC1, err = Matrix4x4Multiply(C, ABC1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Matrix4x4TupleMultiply(ABC1, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !TupleEqual(out, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", ABC1, p, expected, out)
+	}
+
+	ABC2, err := Transformation(A, B, C)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err = Matrix4x4TupleMultiply(ABC2, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !TupleEqual(out, expected) {
+		t.Errorf("Expected %v * %v to be %v but got %v", ABC1, p, expected, out)
+	}
+}
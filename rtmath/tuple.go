@@ -0,0 +1,77 @@
+package rtmath
+
+import "math"
+
+type Tuple struct {
+	X float64
+	Y float64
+	Z float64
+	W float64
+}
+
+func TupleEqual(a Tuple, b Tuple) bool {
+	return FloatEqual(a.X, b.X) && FloatEqual(a.Y, b.Y) && FloatEqual(a.Z, b.Z) && FloatEqual(a.W, b.W)
+}
+
+func TupleAdd(a Tuple, b Tuple) Tuple {
+	return Tuple{a.X + b.X, a.Y + b.Y, a.Z + b.Z, a.W + b.W}
+}
+
+func TupleSubtract(a Tuple, b Tuple) Tuple {
+	return Tuple{a.X - b.X, a.Y - b.Y, a.Z - b.Z, a.W - b.W}
+}
+
+func TupleScale(a Tuple, k float64) Tuple {
+	return Tuple{a.X * k, a.Y * k, a.Z * k, a.W * k}
+}
+
+func TupleDivide(a Tuple, k float64) Tuple {
+	return Tuple{a.X / k, a.Y / k, a.Z / k, a.W / k}
+}
+
+func TupleNegate(a Tuple) Tuple {
+	return Tuple{-a.X, -a.Y, -a.Z, -a.W}
+}
+
+func Point(X float64, Y float64, Z float64) Tuple {
+	return Tuple{X, Y, Z, 1.0}
+}
+
+func Vector(X float64, Y float64, Z float64) Tuple {
+	return Tuple{X, Y, Z, 0.0}
+}
+
+func VectorMagnitude(a Tuple) float64 {
+	return math.Sqrt(a.X*a.X + a.Y*a.Y + a.Z*a.Z + a.W*a.W)
+}
+
+func VectorNormalize(a Tuple) Tuple {
+	m := VectorMagnitude(a)
+
+	return Tuple{a.X / m, a.Y / m, a.Z / m, a.W / m}
+}
+
+func VectorDot(a Tuple, b Tuple) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W + b.W
+}
+
+func VectorCross(a Tuple, b Tuple) Tuple {
+	return Vector(
+		a.Y*b.Z-a.Z*b.Y,
+		a.Z*b.X-a.X*b.Z,
+		a.X*b.Y-a.Y*b.X,
+	)
+}
+
+func IsPoint(t Tuple) bool {
+	return t.W == 1.0
+}
+
+func IsVector(t Tuple) bool {
+	return t.W == 0.0
+}
+
+func VectorNormalReflect(in Tuple, normal Tuple) Tuple {
+	d := VectorDot(in, normal) * 2
+	return TupleSubtract(in, TupleScale(normal, d))
+}
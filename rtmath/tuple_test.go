@@ -1,4 +1,4 @@
-package main
+package rtmath
 
 import (
 	"math"
@@ -8,22 +8,22 @@ import (
 func TestTupleW1IsPoint(t *testing.T) {
 	a := Tuple{4.3, -4.2, 3.1, 1.0}
 
-	if !floatEqual(a.X, 4.3) {
+	if !FloatEqual(a.X, 4.3) {
 		t.Errorf("Expected x to be 4.3 but got %f", a.X)
 	}
-	if !floatEqual(a.Y, -4.2) {
+	if !FloatEqual(a.Y, -4.2) {
 		t.Errorf("Expected y to be -4.2 but got %f", a.Y)
 	}
-	if !floatEqual(a.Z, 3.1) {
+	if !FloatEqual(a.Z, 3.1) {
 		t.Errorf("Expected z to be 3.1 but got %f", a.Z)
 	}
-	if !floatEqual(a.W, 1.0) {
+	if !FloatEqual(a.W, 1.0) {
 		t.Errorf("Expected w to be 1.0 but got %f", a.W)
 	}
-	if !isPoint(a) {
+	if !IsPoint(a) {
 		t.Errorf("Expected a to be a point but it is not")
 	}
-	if isVector(a) {
+	if IsVector(a) {
 		t.Errorf("Expected a not to be a vector but it is")
 	}
 }
@@ -31,40 +31,40 @@ func TestTupleW1IsPoint(t *testing.T) {
 func TestTupleW0IsVector(t *testing.T) {
 	a := Tuple{4.3, -4.2, 3.1, 0.0}
 
-	if !floatEqual(a.X, 4.3) {
+	if !FloatEqual(a.X, 4.3) {
 		t.Errorf("Expected x to be 4.3 but got %f", a.X)
 	}
-	if !floatEqual(a.Y, -4.2) {
+	if !FloatEqual(a.Y, -4.2) {
 		t.Errorf("Expected y to be -4.2 but got %f", a.Y)
 	}
-	if !floatEqual(a.Z, 3.1) {
+	if !FloatEqual(a.Z, 3.1) {
 		t.Errorf("Expected z to be 3.1 but got %f", a.Z)
 	}
-	if !floatEqual(a.W, 0.0) {
+	if !FloatEqual(a.W, 0.0) {
 		t.Errorf("Expected w to be 0.0 but got %f", a.W)
 	}
-	if isPoint(a) {
+	if IsPoint(a) {
 		t.Errorf("Expected a not to be a point but it is")
 	}
-	if !isVector(a) {
+	if !IsVector(a) {
 		t.Errorf("Expected a to be a vector but it is not")
 	}
 }
 
 func TestPointCreatesTupleW1(t *testing.T) {
-	p := point(4, -4, 3)
+	p := Point(4, -4, 3)
 	pp := Tuple{4, -4, 3, 1.0}
 
-	if !tupleEqual(p, pp) {
+	if !TupleEqual(p, pp) {
 		t.Errorf("expected point to be %v but got %v", pp, p)
 	}
 }
 
 func TestVectorCreatesTupleW0(t *testing.T) {
-	v := vector(4, -4, 3)
+	v := Vector(4, -4, 3)
 	vv := Tuple{4, -4, 3, 0.0}
 
-	if !tupleEqual(v, vv) {
+	if !TupleEqual(v, vv) {
 		t.Errorf("expected point to be %v but got %v", vv, v)
 	}
 }
@@ -72,100 +72,100 @@ func TestVectorCreatesTupleW0(t *testing.T) {
 func TestAddTwoTuples(t *testing.T) {
 	a1 := Tuple{3, -2, 5, 1}
 	a2 := Tuple{-2, 3, 1, 0}
-	added := tupleAdd(a1, a2)
+	added := TupleAdd(a1, a2)
 	expected := Tuple{1, 1, 6, 1}
 
-	if !tupleEqual(added, expected) {
+	if !TupleEqual(added, expected) {
 		t.Errorf("Expected a1 + a2 to be %v but got %v", expected, added)
 	}
 }
 
 func TestSubtractTwoPoints(t *testing.T) {
-	p1 := point(3, 2, 1)
-	p2 := point(5, 6, 7)
-	subbed := tupleSubtract(p1, p2)
-	expected := vector(-2, -4, -6)
+	p1 := Point(3, 2, 1)
+	p2 := Point(5, 6, 7)
+	subbed := TupleSubtract(p1, p2)
+	expected := Vector(-2, -4, -6)
 
-	if !tupleEqual(subbed, expected) {
+	if !TupleEqual(subbed, expected) {
 		t.Errorf("Expected p1 - p2 to be %v but got %v", expected, subbed)
 	}
 }
 
 func TestSubtractVectorFromPoint(t *testing.T) {
-	p := point(3, 2, 1)
-	v := vector(5, 6, 7)
-	subbed := tupleSubtract(p, v)
-	expected := point(-2, -4, -6)
+	p := Point(3, 2, 1)
+	v := Vector(5, 6, 7)
+	subbed := TupleSubtract(p, v)
+	expected := Point(-2, -4, -6)
 
-	if !tupleEqual(subbed, expected) {
+	if !TupleEqual(subbed, expected) {
 		t.Errorf("Expected p - v to be %v but got %v", expected, subbed)
 	}
 }
 
 func TestSubtractTwoVectors(t *testing.T) {
-	v1 := vector(3, 2, 1)
-	v2 := vector(5, 6, 7)
-	subbed := tupleSubtract(v1, v2)
-	expected := vector(-2, -4, -6)
+	v1 := Vector(3, 2, 1)
+	v2 := Vector(5, 6, 7)
+	subbed := TupleSubtract(v1, v2)
+	expected := Vector(-2, -4, -6)
 
-	if !tupleEqual(subbed, expected) {
+	if !TupleEqual(subbed, expected) {
 		t.Errorf("Expected v1 - v2 to be %v but got %v", expected, subbed)
 	}
 }
 
 func TestSubtractVectorFromZeroVector(t *testing.T) {
-	zero := vector(0, 0, 0)
-	v := vector(1, -2, 3)
-	subbed := tupleSubtract(zero, v)
-	expected := vector(-1, 2, -3)
+	zero := Vector(0, 0, 0)
+	v := Vector(1, -2, 3)
+	subbed := TupleSubtract(zero, v)
+	expected := Vector(-1, 2, -3)
 
-	if !tupleEqual(subbed, expected) {
+	if !TupleEqual(subbed, expected) {
 		t.Errorf("Expected zero - v to be %v but got %v", expected, subbed)
 	}
 }
 
 func TestNegateTuple(t *testing.T) {
 	a := Tuple{1, -2, 3, -4}
-	aNeg := tupleNegate(a)
+	aNeg := TupleNegate(a)
 	expected := Tuple{-1, 2, -3, 4}
 
-	if !tupleEqual(aNeg, expected) {
+	if !TupleEqual(aNeg, expected) {
 		t.Errorf("Expected -a to be %v but got %v", expected, aNeg)
 	}
 }
 
 func TestMultiplyTupleByScalar(t *testing.T) {
 	a := Tuple{1, -2, 3, -4}
-	ak := tupleScale(a, 3.5)
+	ak := TupleScale(a, 3.5)
 	expected := Tuple{3.5, -7, 10.5, -14}
 
-	if !tupleEqual(ak, expected) {
+	if !TupleEqual(ak, expected) {
 		t.Errorf("Expected a * 3.5 to be %v but got %v", expected, ak)
 	}
 }
 
 func TestMultiplyTupleByFraction(t *testing.T) {
 	a := Tuple{1, -2, 3, -4}
-	ak := tupleScale(a, 0.5)
+	ak := TupleScale(a, 0.5)
 	expected := Tuple{0.5, -1, 1.5, -2}
 
-	if !tupleEqual(ak, expected) {
+	if !TupleEqual(ak, expected) {
 		t.Errorf("Expected a * 0.5 to be %v but got %v", expected, ak)
 	}
 }
 
 func TestDivideTupleByScalar(t *testing.T) {
 	a := Tuple{1, -2, 3, -4}
-	ak := tupleDivide(a, 2)
+	ak := TupleDivide(a, 2)
 	expected := Tuple{0.5, -1, 1.5, -2}
 
-	if !tupleEqual(ak, expected) {
+	if !TupleEqual(ak, expected) {
 		t.Errorf("Expected a / 2 to be %v but got %v", expected, ak)
 	}
 }
 
 func TestVectorMagnitude(t *testing.T) {
-	vs := []Tuple{vector(1, 0, 0), vector(0, 1, 0), vector(0, 0, 1), vector(1, 2, 3), vector(-1, -2, -3)}
+	vs := []Tuple{Vector(1, 0, 0), Vector(0, 1, 0), Vector(0, 0, 1), Vector(1, 2, 3), Vector(-1, -2, -3)}
 	expecteds := []float64{1, 1, 1, math.Sqrt(14), math.Sqrt(14)}
 
 	if len(vs) != len(expecteds) {
@@ -173,57 +173,79 @@ func TestVectorMagnitude(t *testing.T) {
 	}
 
 	for i := 0; i < len(vs); i++ {
-		if !floatEqual(vectorMagnitude(vs[i]), expecteds[i]) {
-			t.Errorf("Expected %v to have magnitude %f but got %f", vs[i], expecteds[i], vectorMagnitude(vs[i]))
+		if !FloatEqual(VectorMagnitude(vs[i]), expecteds[i]) {
+			t.Errorf("Expected %v to have magnitude %f but got %f", vs[i], expecteds[i], VectorMagnitude(vs[i]))
 		}
 	}
 }
 
 func TestVectorNormalize(t *testing.T) {
-	vs := []Tuple{vector(4, 0, 0), vector(1, 2, 3)}
-	expecteds := []Tuple{vector(1, 0, 0), vector(0.26726, 0.53452, 0.80178)}
+	vs := []Tuple{Vector(4, 0, 0), Vector(1, 2, 3)}
+	expecteds := []Tuple{Vector(1, 0, 0), Vector(0.26726, 0.53452, 0.80178)}
 	if len(vs) != len(expecteds) {
 		t.Fatalf("Do not have the same number of vectors and expected values. Cannot continue test")
 	}
 
 	for i := 0; i < len(vs); i++ {
-		if !tupleEqual(vectorNormalize(vs[i]), expecteds[i]) {
-			t.Errorf("Expected %v to be normalized to %v but got %v", vs[i], expecteds[i], vectorNormalize(vs[i]))
+		if !TupleEqual(VectorNormalize(vs[i]), expecteds[i]) {
+			t.Errorf("Expected %v to be normalized to %v but got %v", vs[i], expecteds[i], VectorNormalize(vs[i]))
 		}
 	}
 }
 
 func TestMagnitudeNormalizedVector(t *testing.T) {
-	v := vector(1, 2, 3)
-	norm := vectorNormalize(v)
+	v := Vector(1, 2, 3)
+	norm := VectorNormalize(v)
 
-	if vectorMagnitude(norm) != 1 {
-		t.Errorf("Expected %v to have magnitude 1, but got %f", norm, vectorMagnitude(v))
+	if VectorMagnitude(norm) != 1 {
+		t.Errorf("Expected %v to have magnitude 1, but got %f", norm, VectorMagnitude(v))
 	}
 }
 
 func TestVectorDot(t *testing.T) {
-	a := vector(1, 2, 3)
-	b := vector(2, 3, 4)
+	a := Vector(1, 2, 3)
+	b := Vector(2, 3, 4)
 
-	if vectorDot(a, b) != 20 {
-		t.Errorf("Expected a . b to be 20 but got %f", vectorDot(a, b))
+	if VectorDot(a, b) != 20 {
+		t.Errorf("Expected a . b to be 20 but got %f", VectorDot(a, b))
 	}
 }
 
 func TestVectorCross(t *testing.T) {
-	a := vector(1, 2, 3)
-	b := vector(2, 3, 4)
-	axb := vectorCross(a, b)
-	expectedaxb := vector(-1, 2, -1)
-	bxa := vectorCross(b, a)
-	expectedbxa := vector(1, -2, 1)
-
-	if !tupleEqual(axb, expectedaxb) {
+	a := Vector(1, 2, 3)
+	b := Vector(2, 3, 4)
+	axb := VectorCross(a, b)
+	expectedaxb := Vector(-1, 2, -1)
+	bxa := VectorCross(b, a)
+	expectedbxa := Vector(1, -2, 1)
+
+	if !TupleEqual(axb, expectedaxb) {
 		t.Errorf("Expect %v x %v to be %v but got %v", a, b, expectedaxb, axb)
 	}
 
-	if !tupleEqual(bxa, expectedbxa) {
+	if !TupleEqual(bxa, expectedbxa) {
 		t.Errorf("Expect %v x %v to be %v but got %v", b, a, expectedbxa, bxa)
 	}
 }
+
+func TestReflectVector45Deg(t *testing.T) {
+	v := Vector(1, -1, 0)
+	n := Vector(0, 1, 0)
+	r := VectorNormalReflect(v, n)
+	e := Vector(1, 1, 0)
+
+	if !TupleEqual(r, e) {
+		t.Errorf("Expected %v to be %v", r, e)
+	}
+}
+
+func TestReflectVectorSlantedSurface(t *testing.T) {
+	v := Vector(0, -1, 0)
+	n := Vector(math.Sqrt2/2, math.Sqrt2/2, 0)
+	r := VectorNormalReflect(v, n)
+	e := Vector(1, 0, 0)
+
+	if !TupleEqual(r, e) {
+		t.Errorf("Expected %v to be %v", r, e)
+	}
+}
@@ -0,0 +1,26 @@
+package rtmath
+
+import "math"
+
+const EPSILON = float64(0.00001)
+
+func FloatEqual(a float64, b float64) bool {
+	return math.Abs(a-b) < EPSILON
+}
+
+// Deterministic pseudo random number in [0, 1) derived from keys. Used where
+// sampling has to give the same result no matter what order work is done in.
+func HashToUnit(keys ...uint64) float64 {
+	h := uint64(0x9e3779b97f4a7c15)
+	for _, k := range keys {
+		h = splitMix64(h ^ k)
+	}
+	return float64(h>>11) / (1 << 53)
+}
+
+func splitMix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
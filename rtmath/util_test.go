@@ -0,0 +1,23 @@
+package rtmath
+
+import "testing"
+
+func TestHashToUnit(t *testing.T) {
+	seen := map[float64]bool{}
+	for i := uint64(0); i < 1000; i++ {
+		res := HashToUnit(i, 7)
+		if res < 0 || res >= 1 {
+			t.Errorf("Expected %v to be within 0..1", res)
+		}
+		if res != HashToUnit(i, 7) {
+			t.Errorf("Expected the same keys to give the same number")
+		}
+		seen[res] = true
+	}
+	if len(seen) != 1000 {
+		t.Errorf("Expected different keys to give different numbers but got %d distinct", len(seen))
+	}
+	if HashToUnit(1, 2) == HashToUnit(2, 1) {
+		t.Errorf("Expected the order of keys to matter")
+	}
+}
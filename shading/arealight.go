@@ -0,0 +1,107 @@
+package shading
+
+import (
+	"fmt"
+	"math"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+// A rectangular light split into USteps by VSteps cells, each contributing
+// one sample. Sampling across the rectangle is what gives soft shadows.
+type AreaLight struct {
+	Corner rtmath.Tuple
+	// One cell along each edge
+	UVec   rtmath.Tuple
+	USteps int
+	VVec   rtmath.Tuple
+	VSteps int
+	// Center of the rectangle
+	Position    rtmath.Tuple
+	Intensity   canvas.Color
+	Attenuation Attenuation
+	// Places samples randomly within their cells instead of at the centers,
+	// trading banding for noise
+	Jitter bool
+}
+
+// fullUVec and fullVVec are the edges of the rectangle starting at corner
+func NewAreaLight(corner rtmath.Tuple,
+	fullUVec rtmath.Tuple,
+	uSteps int,
+	fullVVec rtmath.Tuple,
+	vSteps int,
+	intensity canvas.Color,
+) (AreaLight, error) {
+	if !rtmath.IsPoint(corner) {
+		return AreaLight{}, fmt.Errorf("can only make AreaLight with point corner but got %v", corner)
+	}
+	if !rtmath.IsVector(fullUVec) || !rtmath.IsVector(fullVVec) {
+		return AreaLight{}, fmt.Errorf("can only make AreaLight with vector edges but got %v and %v", fullUVec, fullVVec)
+	}
+	if uSteps < 1 || vSteps < 1 {
+		return AreaLight{}, fmt.Errorf("AreaLight needs at least one cell in each direction but got %dx%d", uSteps, vSteps)
+	}
+
+	position := rtmath.TupleAdd(corner,
+		rtmath.TupleAdd(rtmath.TupleScale(fullUVec, 0.5), rtmath.TupleScale(fullVVec, 0.5)))
+	return AreaLight{
+		Corner:      corner,
+		UVec:        rtmath.TupleScale(fullUVec, 1/float64(uSteps)),
+		USteps:      uSteps,
+		VVec:        rtmath.TupleScale(fullVVec, 1/float64(vSteps)),
+		VSteps:      vSteps,
+		Position:    position,
+		Intensity:   intensity,
+		Attenuation: NoAttenuation(),
+		Jitter:      true,
+	}, nil
+}
+
+// jitterU and jitterV place the sample within cell u, v and go from 0 to 1
+func AreaLightPointOn(l AreaLight, u int, v int, jitterU float64, jitterV float64) rtmath.Tuple {
+	return rtmath.TupleAdd(l.Corner,
+		rtmath.TupleAdd(
+			rtmath.TupleScale(l.UVec, float64(u)+jitterU),
+			rtmath.TupleScale(l.VVec, float64(v)+jitterV)))
+}
+
+// Sample positions used to light p, one per cell. Jitter is derived from p so
+// the same point always sees the same samples, which keeps renders
+// reproducible no matter what order pixels are shaded in.
+func AreaLightSamples(l AreaLight, p rtmath.Tuple) []rtmath.Tuple {
+	samples := make([]rtmath.Tuple, 0, l.USteps*l.VSteps)
+	for v := 0; v < l.VSteps; v++ {
+		for u := 0; u < l.USteps; u++ {
+			jitterU, jitterV := 0.5, 0.5
+			if l.Jitter {
+				jitterU = hashPointToUnit(p, u, v, 0)
+				jitterV = hashPointToUnit(p, u, v, 1)
+			}
+			samples = append(samples, AreaLightPointOn(l, u, v, jitterU, jitterV))
+		}
+	}
+	return samples
+}
+
+func (l AreaLight) GetIntensity() canvas.Color {
+	return l.Intensity
+}
+
+// Uses the distance to the center of the light
+func (l AreaLight) FalloffAt(p rtmath.Tuple) float64 {
+	return AttenuationAt(l.Attenuation, rtmath.VectorMagnitude(rtmath.TupleSubtract(l.Position, p)))
+}
+
+func (l AreaLight) LightSamples(p rtmath.Tuple) []LightSample {
+	samples := []LightSample{}
+	for _, position := range AreaLightSamples(l, p) {
+		samples = append(samples, lightSampleTowards(p, position))
+	}
+	return samples
+}
+
+func hashPointToUnit(p rtmath.Tuple, u int, v int, axis int) float64 {
+	return rtmath.HashToUnit(math.Float64bits(p.X), math.Float64bits(p.Y), math.Float64bits(p.Z), uint64(u), uint64(v), uint64(axis))
+}
@@ -0,0 +1,110 @@
+package shading
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestCreateAreaLight(t *testing.T) {
+	l, err := NewAreaLight(rtmath.Point(0, 0, 0), rtmath.Vector(2, 0, 0), 4, rtmath.Vector(0, 0, 1), 2, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rtmath.TupleEqual(l.Corner, rtmath.Point(0, 0, 0)) ||
+		!rtmath.TupleEqual(l.UVec, rtmath.Vector(0.5, 0, 0)) ||
+		l.USteps != 4 ||
+		!rtmath.TupleEqual(l.VVec, rtmath.Vector(0, 0, 0.5)) ||
+		l.VSteps != 2 ||
+		!rtmath.TupleEqual(l.Position, rtmath.Point(1, 0, 0.5)) ||
+		!canvas.ColorEqual(l.Intensity, white) ||
+		!l.Jitter {
+		t.Errorf("AreaLight was not set, got %v", l)
+	}
+}
+
+func TestCreateAreaLightWithInvalidArguments(t *testing.T) {
+	type testCase struct {
+		corner rtmath.Tuple
+		u      rtmath.Tuple
+		uSteps int
+		v      rtmath.Tuple
+		vSteps int
+	}
+	cases := []testCase{
+		{rtmath.Vector(0, 0, 0), rtmath.Vector(2, 0, 0), 4, rtmath.Vector(0, 0, 1), 2},
+		{rtmath.Point(0, 0, 0), rtmath.Point(2, 0, 0), 4, rtmath.Vector(0, 0, 1), 2},
+		{rtmath.Point(0, 0, 0), rtmath.Vector(2, 0, 0), 0, rtmath.Vector(0, 0, 1), 2},
+		{rtmath.Point(0, 0, 0), rtmath.Vector(2, 0, 0), 4, rtmath.Vector(0, 0, 1), -1},
+	}
+	for _, c := range cases {
+		_, err := NewAreaLight(c.corner, c.u, c.uSteps, c.v, c.vSteps, white)
+		if err == nil {
+			t.Errorf("Expected an error for %v", c)
+		}
+	}
+}
+
+func TestAreaLightPointOn(t *testing.T) {
+	l, err := NewAreaLight(rtmath.Point(0, 0, 0), rtmath.Vector(2, 0, 0), 4, rtmath.Vector(0, 0, 1), 2, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		u        int
+		v        int
+		expected rtmath.Tuple
+	}
+	cases := []testCase{
+		{0, 0, rtmath.Point(0.25, 0, 0.25)},
+		{1, 0, rtmath.Point(0.75, 0, 0.25)},
+		{0, 1, rtmath.Point(0.25, 0, 0.75)},
+		{2, 0, rtmath.Point(1.25, 0, 0.25)},
+		{3, 1, rtmath.Point(1.75, 0, 0.75)},
+	}
+	for _, c := range cases {
+		res := AreaLightPointOn(l, c.u, c.v, 0.5, 0.5)
+		if !rtmath.TupleEqual(res, c.expected) {
+			t.Errorf("Expected %v to be %v", res, c.expected)
+		}
+	}
+}
+
+func TestAreaLightSamples(t *testing.T) {
+	l, err := NewAreaLight(rtmath.Point(0, 0, 0), rtmath.Vector(2, 0, 0), 4, rtmath.Vector(0, 0, 1), 2, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := rtmath.Point(0.3, 4, -1.2)
+
+	l.Jitter = false
+	centers := AreaLightSamples(l, p)
+	if len(centers) != 8 {
+		t.Fatalf("Expected 8 samples but got %d", len(centers))
+	}
+	if !rtmath.TupleEqual(centers[0], rtmath.Point(0.25, 0, 0.25)) || !rtmath.TupleEqual(centers[7], rtmath.Point(1.75, 0, 0.75)) {
+		t.Errorf("Expected samples at cell centers but got %v", centers)
+	}
+
+	l.Jitter = true
+	jittered := AreaLightSamples(l, p)
+	again := AreaLightSamples(l, p)
+	other := AreaLightSamples(l, rtmath.Point(0.3, 4, -1.1))
+	for i := range jittered {
+		// Each sample stays inside its own cell
+		cell := centers[i]
+		if jittered[i].X < cell.X-0.25 || jittered[i].X > cell.X+0.25 ||
+			jittered[i].Z < cell.Z-0.25 || jittered[i].Z > cell.Z+0.25 ||
+			jittered[i].Y != 0 {
+			t.Errorf("Expected %v to be inside the cell around %v", jittered[i], cell)
+		}
+		if !rtmath.TupleEqual(jittered[i], again[i]) {
+			t.Errorf("Expected the same point to get the same samples but got %v and %v", jittered[i], again[i])
+		}
+	}
+	if rtmath.TupleEqual(jittered[0], other[0]) && rtmath.TupleEqual(jittered[1], other[1]) {
+		t.Errorf("Expected different points to get different samples")
+	}
+}
@@ -0,0 +1,179 @@
+package shading
+
+import (
+	"fmt"
+	"math"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+// Light is implemented by every kind of light source. Lighting averages the
+// diffuse and specular contributions over LightSamples and scales them by
+// FalloffAt; shadows are found by casting a ray along each sample.
+type Light interface {
+	GetIntensity() canvas.Color
+	// Fraction of the intensity that arrives at p, ignoring shadows
+	FalloffAt(p rtmath.Tuple) float64
+	LightSamples(p rtmath.Tuple) []LightSample
+}
+
+// Where light arrives at a point from
+type LightSample struct {
+	// Unit vector from the point towards the light
+	Direction rtmath.Tuple
+	// How far along Direction the light is, infinite for directional lights
+	Distance float64
+}
+
+// How light fades with distance, 1 / (Constant + Linear*d + Quadratic*d*d).
+// The zero value means no attenuation.
+type Attenuation struct {
+	Constant  float64
+	Linear    float64
+	Quadratic float64
+	// Beyond this distance the light has no effect at all, 0 means no limit
+	Range float64
+}
+
+func NewAttenuation(constant float64, linear float64, quadratic float64) (Attenuation, error) {
+	if constant < 0 || linear < 0 || quadratic < 0 || constant+linear+quadratic == 0 {
+		return Attenuation{}, fmt.Errorf("attenuation terms must not be negative or all zero but got %v, %v, %v", constant, linear, quadratic)
+	}
+	return Attenuation{constant, linear, quadratic, 0}, nil
+}
+
+// Light keeps its full intensity at any distance
+func NoAttenuation() Attenuation {
+	return Attenuation{1, 0, 0, 0}
+}
+
+// Physically based falloff, intensity is what arrives one unit away
+func InverseSquareAttenuation() Attenuation {
+	return Attenuation{0, 0, 1, 0}
+}
+
+// Fraction of a light's intensity left after travelling distance
+func AttenuationAt(a Attenuation, distance float64) float64 {
+	if a.Range > 0 && distance > a.Range {
+		return 0
+	}
+	denominator := a.Constant + a.Linear*distance + a.Quadratic*distance*distance
+	if denominator <= 0 {
+		return 1
+	}
+	return 1 / denominator
+}
+
+func lightSampleTowards(p rtmath.Tuple, position rtmath.Tuple) LightSample {
+	v := rtmath.TupleSubtract(position, p)
+	return LightSample{rtmath.VectorNormalize(v), rtmath.VectorMagnitude(v)}
+}
+
+// Shines equally in every direction from a single point
+type PointLight struct {
+	Position    rtmath.Tuple
+	Intensity   canvas.Color
+	Attenuation Attenuation
+}
+
+func NewPointLight(p rtmath.Tuple, i canvas.Color) (PointLight, error) {
+	if !rtmath.IsPoint(p) {
+		return PointLight{}, fmt.Errorf("can only make PointLight with point but got %v", p)
+	}
+	return PointLight{p, i, NoAttenuation()}, nil
+}
+
+func (l PointLight) GetIntensity() canvas.Color {
+	return l.Intensity
+}
+
+func (l PointLight) FalloffAt(p rtmath.Tuple) float64 {
+	return AttenuationAt(l.Attenuation, rtmath.VectorMagnitude(rtmath.TupleSubtract(l.Position, p)))
+}
+
+func (l PointLight) LightSamples(p rtmath.Tuple) []LightSample {
+	return []LightSample{lightSampleTowards(p, l.Position)}
+}
+
+// Parallel rays from infinitely far away, like the sun
+type DirectionalLight struct {
+	// Unit vector in the direction the light travels
+	Direction rtmath.Tuple
+	Intensity canvas.Color
+}
+
+func NewDirectionalLight(direction rtmath.Tuple, i canvas.Color) (DirectionalLight, error) {
+	if !rtmath.IsVector(direction) || rtmath.FloatEqual(rtmath.VectorMagnitude(direction), 0) {
+		return DirectionalLight{}, fmt.Errorf("can only make DirectionalLight with non-zero vector but got %v", direction)
+	}
+	return DirectionalLight{rtmath.VectorNormalize(direction), i}, nil
+}
+
+func (l DirectionalLight) GetIntensity() canvas.Color {
+	return l.Intensity
+}
+
+func (l DirectionalLight) FalloffAt(p rtmath.Tuple) float64 {
+	return 1
+}
+
+func (l DirectionalLight) LightSamples(p rtmath.Tuple) []LightSample {
+	return []LightSample{{rtmath.TupleNegate(l.Direction), math.Inf(1)}}
+}
+
+// A point light limited to a cone. Inside InnerAngle it is at full
+// intensity, past OuterAngle it is dark, and in between it fades smoothly.
+// Both angles are measured from Direction, in radians.
+type SpotLight struct {
+	Position rtmath.Tuple
+	// Unit vector along the middle of the cone
+	Direction   rtmath.Tuple
+	InnerAngle  float64
+	OuterAngle  float64
+	Intensity   canvas.Color
+	Attenuation Attenuation
+}
+
+func NewSpotLight(position rtmath.Tuple,
+	direction rtmath.Tuple,
+	innerAngle float64,
+	outerAngle float64,
+	i canvas.Color,
+) (SpotLight, error) {
+	if !rtmath.IsPoint(position) {
+		return SpotLight{}, fmt.Errorf("can only make SpotLight with point but got %v", position)
+	}
+	if !rtmath.IsVector(direction) || rtmath.FloatEqual(rtmath.VectorMagnitude(direction), 0) {
+		return SpotLight{}, fmt.Errorf("can only make SpotLight with non-zero direction but got %v", direction)
+	}
+	if innerAngle < 0 || outerAngle < innerAngle || outerAngle > math.Pi {
+		return SpotLight{}, fmt.Errorf("SpotLight needs 0 <= inner <= outer <= pi but got %v and %v", innerAngle, outerAngle)
+	}
+	return SpotLight{position, rtmath.VectorNormalize(direction), innerAngle, outerAngle, i, NoAttenuation()}, nil
+}
+
+func (l SpotLight) GetIntensity() canvas.Color {
+	return l.Intensity
+}
+
+func (l SpotLight) FalloffAt(p rtmath.Tuple) float64 {
+	v := rtmath.TupleSubtract(p, l.Position)
+	attenuation := AttenuationAt(l.Attenuation, rtmath.VectorMagnitude(v))
+	cos := rtmath.VectorDot(rtmath.VectorNormalize(v), l.Direction)
+	cosInner, cosOuter := math.Cos(l.InnerAngle), math.Cos(l.OuterAngle)
+	if cos >= cosInner {
+		return attenuation
+	}
+	if cos <= cosOuter {
+		return 0
+	}
+
+	// Smoothstep between the two cones
+	t := (cos - cosOuter) / (cosInner - cosOuter)
+	return attenuation * t * t * (3 - 2*t)
+}
+
+func (l SpotLight) LightSamples(p rtmath.Tuple) []LightSample {
+	return []LightSample{lightSampleTowards(p, l.Position)}
+}
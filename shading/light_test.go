@@ -0,0 +1,258 @@
+package shading
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestPointLightHasPositionAndIntensity(t *testing.T) {
+	i := canvas.NewColor(1, 1, 1)
+	p := rtmath.Point(0, 0, 0)
+	l, err := NewPointLight(p, i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !canvas.ColorEqual(l.Intensity, i) || !rtmath.TupleEqual(l.Position, p) {
+		t.Errorf("PointLight was not set, got %v", l)
+	}
+}
+
+func TestLightSamples(t *testing.T) {
+	point, err := NewPointLight(rtmath.Point(0, 10, 0), white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	directional, err := NewDirectionalLight(rtmath.Vector(0, -2, 0), white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spot, err := NewSpotLight(rtmath.Point(3, 0, 4), rtmath.Vector(-1, 0, 0), math.Pi/8, math.Pi/4, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		light     Light
+		direction rtmath.Tuple
+		distance  float64
+	}
+	cases := []testCase{
+		{point, rtmath.Vector(0, 1, 0), 10},
+		{directional, rtmath.Vector(0, 1, 0), math.Inf(1)},
+		{spot, rtmath.Vector(0.6, 0, 0.8), 5},
+	}
+	for _, c := range cases {
+		samples := c.light.LightSamples(rtmath.Point(0, 0, 0))
+		if len(samples) != 1 {
+			t.Fatalf("Expected one sample but got %v", samples)
+		}
+		s := samples[0]
+		if !rtmath.TupleEqual(s.Direction, c.direction) || !(s.Distance == c.distance || rtmath.FloatEqual(s.Distance, c.distance)) {
+			t.Errorf("Expected %v to point along %v for %v", s, c.direction, c.distance)
+		}
+	}
+}
+
+func TestCreateLightsWithInvalidArguments(t *testing.T) {
+	_, err := NewDirectionalLight(rtmath.Point(0, -1, 0), white)
+	if err == nil {
+		t.Errorf("Expected an error for a directional light with a point")
+	}
+	_, err = NewDirectionalLight(rtmath.Vector(0, 0, 0), white)
+	if err == nil {
+		t.Errorf("Expected an error for a directional light with no direction")
+	}
+	_, err = NewSpotLight(rtmath.Vector(0, 0, 0), rtmath.Vector(0, -1, 0), 0.1, 0.2, white)
+	if err == nil {
+		t.Errorf("Expected an error for a spot light with a vector position")
+	}
+	_, err = NewSpotLight(rtmath.Point(0, 0, 0), rtmath.Vector(0, -1, 0), 0.3, 0.2, white)
+	if err == nil {
+		t.Errorf("Expected an error for a spot light with the inner cone wider than the outer")
+	}
+}
+
+func TestSpotLightFalloff(t *testing.T) {
+	spot, err := NewSpotLight(rtmath.Point(0, 10, 0), rtmath.Vector(0, -1, 0), math.Pi/6, math.Pi/3, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Halfway between the cones in cosine, where smoothstep gives 0.5
+	cosMid := (math.Cos(math.Pi/6) + math.Cos(math.Pi/3)) / 2
+	mid := math.Acos(cosMid)
+
+	type testCase struct {
+		point    rtmath.Tuple
+		expected float64
+	}
+	cases := []testCase{
+		{rtmath.Point(0, 0, 0), 1},
+		{rtmath.Point(10*math.Tan(math.Pi/8), 0, 0), 1},
+		{rtmath.Point(10*math.Tan(mid), 0, 0), 0.5},
+		{rtmath.Point(0, 0, 10*math.Tan(math.Pi/3)+0.01), 0},
+		{rtmath.Point(0, 20, 0), 0},
+	}
+	for _, c := range cases {
+		res := spot.FalloffAt(c.point)
+		if !rtmath.FloatEqual(res, c.expected) {
+			t.Errorf("Expected falloff at %v to be %v but got %v", c.point, c.expected, res)
+		}
+	}
+}
+
+func TestLightingWithDirectionalLight(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	// Matches a point light straight in front of the surface however far away
+	light, err := NewDirectionalLight(rtmath.Vector(0, 0, 1), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(1.9, 1.9, 1.9)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestLightingOutsideSpotLightCone(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	// Right in front of the surface but aimed away from it
+	light, err := NewSpotLight(rtmath.Point(0, 0, -10), rtmath.Vector(0, 1, 0), math.Pi/8, math.Pi/4, canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(0.1, 0.1, 0.1)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestAttenuationAt(t *testing.T) {
+	linear, err := NewAttenuation(1, 0.5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranged := NoAttenuation()
+	ranged.Range = 10
+
+	type testCase struct {
+		attenuation Attenuation
+		distance    float64
+		expected    float64
+	}
+	cases := []testCase{
+		{NoAttenuation(), 0, 1},
+		{NoAttenuation(), 1000, 1},
+		{Attenuation{}, 1000, 1},
+		{InverseSquareAttenuation(), 1, 1},
+		{InverseSquareAttenuation(), 2, 0.25},
+		{InverseSquareAttenuation(), 10, 0.01},
+		{linear, 2, 0.5},
+		{linear, 6, 0.25},
+		{ranged, 9.9, 1},
+		{ranged, 10.1, 0},
+	}
+	for _, c := range cases {
+		res := AttenuationAt(c.attenuation, c.distance)
+		if !rtmath.FloatEqual(res, c.expected) {
+			t.Errorf("Expected %v at %v to be %v but got %v", c.attenuation, c.distance, c.expected, res)
+		}
+	}
+}
+
+func TestCreateAttenuationWithInvalidTerms(t *testing.T) {
+	type testCase struct {
+		constant  float64
+		linear    float64
+		quadratic float64
+	}
+	cases := []testCase{
+		{0, 0, 0},
+		{-1, 0, 1},
+		{1, -0.1, 0},
+	}
+	for _, c := range cases {
+		_, err := NewAttenuation(c.constant, c.linear, c.quadratic)
+		if err == nil {
+			t.Errorf("Expected an error for %v", c)
+		}
+	}
+}
+
+func TestLightFalloffWithAttenuation(t *testing.T) {
+	point, err := NewPointLight(rtmath.Point(0, 10, 0), white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	point.Attenuation = InverseSquareAttenuation()
+	spot, err := NewSpotLight(rtmath.Point(0, 10, 0), rtmath.Vector(0, -1, 0), math.Pi/6, math.Pi/3, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spot.Attenuation = InverseSquareAttenuation()
+	area, err := NewAreaLight(rtmath.Point(-1, 10, -1), rtmath.Vector(2, 0, 0), 2, rtmath.Vector(0, 0, 2), 2, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	area.Attenuation = InverseSquareAttenuation()
+	directional, err := NewDirectionalLight(rtmath.Vector(0, -1, 0), white)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		light    Light
+		point    rtmath.Tuple
+		expected float64
+	}
+	cases := []testCase{
+		{point, rtmath.Point(0, 0, 0), 0.01},
+		{point, rtmath.Point(0, 8, 0), 0.25},
+		{spot, rtmath.Point(0, 0, 0), 0.01},
+		// Outside the cone attenuation doesn't bring any light back
+		{spot, rtmath.Point(0, 20, 0), 0},
+		{area, rtmath.Point(0, 5, 0), 0.04},
+		{directional, rtmath.Point(0, -1000, 0), 1},
+	}
+	for _, c := range cases {
+		res := c.light.FalloffAt(c.point)
+		if !rtmath.FloatEqual(res, c.expected) {
+			t.Errorf("Expected falloff of %v at %v to be %v but got %v", c.light, c.point, c.expected, res)
+		}
+	}
+}
+
+func TestLightingWithAttenuatedLight(t *testing.T) {
+	m, p := lightingBackground()
+	m.Specular = 0
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 0, -2), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	light.Attenuation = InverseSquareAttenuation()
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ambient is left alone, diffuse is a quarter of 0.9
+	expect := canvas.NewColor(0.325, 0.325, 0.325)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
@@ -0,0 +1,79 @@
+package shading
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestNoiseMatchesReferenceImplementation(t *testing.T) {
+	res := Noise(rtmath.Point(3.14, 42, 7))
+	expected := 0.13691995878400012
+	if !rtmath.FloatEqual(res, expected) {
+		t.Errorf("Expected %v to be %v", res, expected)
+	}
+}
+
+func TestNoiseIsZeroAtWholeNumbers(t *testing.T) {
+	for _, p := range []rtmath.Tuple{rtmath.Point(0, 0, 0), rtmath.Point(1, -2, 3), rtmath.Point(-300, 17, 256)} {
+		res := Noise(p)
+		if res != 0 {
+			t.Errorf("Expected noise at %v to be 0 but got %v", p, res)
+		}
+	}
+}
+
+func TestNoiseIsBoundedAndSmooth(t *testing.T) {
+	step := 0.001
+	for x := -2.; x < 2; x += 0.173 {
+		for y := -2.; y < 2; y += 0.219 {
+			for z := -2.; z < 2; z += 0.241 {
+				a := Noise(rtmath.Point(x, y, z))
+				b := Noise(rtmath.Point(x+step, y, z))
+				if a < -1 || a > 1 {
+					t.Fatalf("Expected noise at %v, %v, %v to be within -1..1 but got %v", x, y, z, a)
+				}
+				if math.Abs(a-b) > 0.01 {
+					t.Fatalf("Expected noise to change smoothly at %v, %v, %v but jumped from %v to %v", x, y, z, a, b)
+				}
+			}
+		}
+	}
+}
+
+func TestFractalNoiseWithOneOctaveIsNoise(t *testing.T) {
+	p := rtmath.Point(0.3, 1.7, -2.2)
+	res := FractalNoise(p, 1)
+	expected := Noise(p)
+	if !rtmath.FloatEqual(res, expected) {
+		t.Errorf("Expected %v to be %v", res, expected)
+	}
+}
+
+func TestTurbulence(t *testing.T) {
+	type testCase struct {
+		point   rtmath.Tuple
+		octaves int
+	}
+	cases := []testCase{
+		{rtmath.Point(0.3, 1.7, -2.2), 1},
+		{rtmath.Point(0.3, 1.7, -2.2), 4},
+		{rtmath.Point(-5.5, 0.25, 9.1), 6},
+	}
+	for _, c := range cases {
+		res := Turbulence(c.point, c.octaves)
+		if res < 0 || res > 1 {
+			t.Errorf("Expected turbulence at %v to be within 0..1 but got %v", c.point, res)
+		}
+	}
+
+	res := Turbulence(rtmath.Point(0.3, 1.7, -2.2), 1)
+	expected := math.Abs(Noise(rtmath.Point(0.3, 1.7, -2.2)))
+	if !rtmath.FloatEqual(res, expected) {
+		t.Errorf("Expected %v to be %v", res, expected)
+	}
+	if Turbulence(rtmath.Point(0.3, 1.7, -2.2), 0) != 0 {
+		t.Errorf("Expected turbulence with no octaves to be 0")
+	}
+}
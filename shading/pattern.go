@@ -0,0 +1,174 @@
+package shading
+
+import (
+	"math"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+// Pattern colors a surface by position instead of with a flat Material.Color.
+// LocalPatternAt works in pattern space; PatternAt converts from object space
+// with the pattern's own transform.
+type Pattern interface {
+	LocalPatternAt(p rtmath.Tuple) (canvas.Color, error)
+	GetTransform() rtmath.Matrix
+}
+
+// BasePattern holds the state shared by all patterns and is meant to be embedded
+type BasePattern struct {
+	Transform rtmath.Matrix
+}
+
+func newBasePattern() BasePattern {
+	return BasePattern{rtmath.MatrixConstructIdentity(4)}
+}
+
+func (b *BasePattern) GetTransform() rtmath.Matrix {
+	return b.Transform
+}
+
+// Takes a point that is already in the object space of the shape being colored
+func PatternAt(pat Pattern, objectPoint rtmath.Tuple) (canvas.Color, error) {
+	inv, err := rtmath.MatrixInverse(pat.GetTransform())
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	p, err := rtmath.Matrix4x4TupleMultiply(inv, objectPoint)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	return pat.LocalPatternAt(p)
+}
+
+// Alternates between A and B every unit along x
+type StripePattern struct {
+	BasePattern
+	A canvas.Color
+	B canvas.Color
+}
+
+func NewStripePattern(a canvas.Color, b canvas.Color) *StripePattern {
+	return &StripePattern{newBasePattern(), a, b}
+}
+
+func (s *StripePattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	if isEven(math.Floor(p.X)) {
+		return s.A, nil
+	}
+	return s.B, nil
+}
+
+// Blends linearly from A to B between x = 0 and x = 1, then repeats
+type GradientPattern struct {
+	BasePattern
+	A canvas.Color
+	B canvas.Color
+}
+
+func NewGradientPattern(a canvas.Color, b canvas.Color) *GradientPattern {
+	return &GradientPattern{newBasePattern(), a, b}
+}
+
+func (g *GradientPattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	distance := canvas.ColorSubtract(g.B, g.A)
+	fraction := p.X - math.Floor(p.X)
+	return canvas.ColorAdd(g.A, canvas.ColorScale(distance, fraction)), nil
+}
+
+// Concentric rings around the y axis, alternating every unit
+type RingPattern struct {
+	BasePattern
+	A canvas.Color
+	B canvas.Color
+}
+
+func NewRingPattern(a canvas.Color, b canvas.Color) *RingPattern {
+	return &RingPattern{newBasePattern(), a, b}
+}
+
+func (r *RingPattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	if isEven(math.Floor(math.Sqrt(p.X*p.X + p.Z*p.Z))) {
+		return r.A, nil
+	}
+	return r.B, nil
+}
+
+// Unit cubes alternating in all three dimensions
+type CheckerPattern struct {
+	BasePattern
+	A canvas.Color
+	B canvas.Color
+}
+
+func NewCheckerPattern(a canvas.Color, b canvas.Color) *CheckerPattern {
+	return &CheckerPattern{newBasePattern(), a, b}
+}
+
+func (c *CheckerPattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	if isEven(math.Floor(p.X) + math.Floor(p.Y) + math.Floor(p.Z)) {
+		return c.A, nil
+	}
+	return c.B, nil
+}
+
+// Jitters points with FractalNoise before looking them up in Pattern, so
+// straight edges become wavy
+type PerturbedPattern struct {
+	BasePattern
+	Pattern Pattern
+	// How far points are moved, at most
+	Scale   float64
+	Octaves int
+}
+
+func NewPerturbedPattern(pattern Pattern, scale float64, octaves int) *PerturbedPattern {
+	return &PerturbedPattern{newBasePattern(), pattern, scale, octaves}
+}
+
+func (pp *PerturbedPattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	// Offsets that aren't whole numbers decorrelate the three axes
+	jitter := rtmath.Vector(
+		FractalNoise(p, pp.Octaves),
+		FractalNoise(rtmath.TupleAdd(p, rtmath.Vector(5.2, 1.3, 7.9)), pp.Octaves),
+		FractalNoise(rtmath.TupleAdd(p, rtmath.Vector(1.7, 9.2, 3.4)), pp.Octaves))
+	return PatternAt(pp.Pattern, rtmath.TupleAdd(p, rtmath.TupleScale(jitter, pp.Scale)))
+}
+
+// Blends from A to B by Turbulence, giving a cloudy look
+type TurbulencePattern struct {
+	BasePattern
+	A       canvas.Color
+	B       canvas.Color
+	Octaves int
+}
+
+func NewTurbulencePattern(a canvas.Color, b canvas.Color, octaves int) *TurbulencePattern {
+	return &TurbulencePattern{newBasePattern(), a, b, octaves}
+}
+
+func (t *TurbulencePattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	f := math.Min(Turbulence(p, t.Octaves), 1)
+	return canvas.ColorAdd(canvas.ColorScale(t.A, 1-f), canvas.ColorScale(t.B, f)), nil
+}
+
+// Thin veins of B running through A along the yz plane
+func NewMarblePattern(a canvas.Color, b canvas.Color) *PerturbedPattern {
+	veins := NewStripePattern(a, b)
+	veins.Transform = rtmath.Scaling(0.2, 1, 1)
+	inner := NewPerturbedPattern(veins, 0.3, 4)
+	inner.Transform = rtmath.Scaling(0.5, 0.5, 0.5)
+	return NewPerturbedPattern(inner, 0.1, 2)
+}
+
+// Growth rings around the y axis that wobble like real wood grain
+func NewWoodPattern(a canvas.Color, b canvas.Color) *PerturbedPattern {
+	rings := NewRingPattern(a, b)
+	rings.Transform = rtmath.Scaling(0.1, 0.1, 0.1)
+	return NewPerturbedPattern(rings, 0.08, 3)
+}
+
+// Expects a whole number
+func isEven(f float64) bool {
+	return math.Mod(f, 2) == 0
+}
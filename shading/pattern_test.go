@@ -0,0 +1,174 @@
+package shading
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+var (
+	white = canvas.NewColor(1, 1, 1)
+	black = canvas.NewColor(0, 0, 0)
+)
+
+func TestCreateStripePattern(t *testing.T) {
+	p := NewStripePattern(white, black)
+	if !canvas.ColorEqual(p.A, white) || !canvas.ColorEqual(p.B, black) {
+		t.Errorf("Stripe pattern was not set, got %v", p)
+	}
+	if !rtmath.MatrixEqual(p.GetTransform(), rtmath.MatrixConstructIdentity(4)) {
+		t.Errorf("Expected %v to be the identity", p.GetTransform())
+	}
+}
+
+func TestPatternsAt(t *testing.T) {
+	type testCase struct {
+		pattern  Pattern
+		point    rtmath.Tuple
+		expected canvas.Color
+	}
+	stripe := NewStripePattern(white, black)
+	gradient := NewGradientPattern(white, black)
+	ring := NewRingPattern(white, black)
+	checker := NewCheckerPattern(white, black)
+	cases := []testCase{
+		// Stripes are constant in y and z
+		{stripe, rtmath.Point(0, 0, 0), white},
+		{stripe, rtmath.Point(0, 1, 0), white},
+		{stripe, rtmath.Point(0, 2, 0), white},
+		{stripe, rtmath.Point(0, 0, 1), white},
+		{stripe, rtmath.Point(0, 0, 2), white},
+		// And alternate in x
+		{stripe, rtmath.Point(0.9, 0, 0), white},
+		{stripe, rtmath.Point(1, 0, 0), black},
+		{stripe, rtmath.Point(-0.1, 0, 0), black},
+		{stripe, rtmath.Point(-1, 0, 0), black},
+		{stripe, rtmath.Point(-1.1, 0, 0), white},
+		{gradient, rtmath.Point(0, 0, 0), white},
+		{gradient, rtmath.Point(0.25, 0, 0), canvas.NewColor(0.75, 0.75, 0.75)},
+		{gradient, rtmath.Point(0.5, 0, 0), canvas.NewColor(0.5, 0.5, 0.5)},
+		{gradient, rtmath.Point(0.75, 0, 0), canvas.NewColor(0.25, 0.25, 0.25)},
+		{ring, rtmath.Point(0, 0, 0), white},
+		{ring, rtmath.Point(1, 0, 0), black},
+		{ring, rtmath.Point(0, 0, 1), black},
+		{ring, rtmath.Point(0.708, 0, 0.708), black},
+		// Checkers repeat in all three dimensions
+		{checker, rtmath.Point(0, 0, 0), white},
+		{checker, rtmath.Point(0.99, 0, 0), white},
+		{checker, rtmath.Point(1.01, 0, 0), black},
+		{checker, rtmath.Point(0, 0.99, 0), white},
+		{checker, rtmath.Point(0, 1.01, 0), black},
+		{checker, rtmath.Point(0, 0, 0.99), white},
+		{checker, rtmath.Point(0, 0, 1.01), black},
+	}
+	for _, c := range cases {
+		res, err := PatternAt(c.pattern, c.point)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v at %v to be %v", res, c.point, c.expected)
+		}
+	}
+}
+
+func TestPatternAtWithPatternTransformation(t *testing.T) {
+	p := NewStripePattern(white, black)
+	p.Transform = rtmath.Scaling(2, 2, 2)
+	res, err := PatternAt(p, rtmath.Point(1.5, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !canvas.ColorEqual(res, white) {
+		t.Errorf("Expected %v to be %v", res, white)
+	}
+}
+
+func TestPerturbedPatternWithoutScaleMatchesInnerPattern(t *testing.T) {
+	stripe := NewStripePattern(white, black)
+	p := NewPerturbedPattern(stripe, 0, 3)
+	for x := -2.; x < 2; x += 0.13 {
+		point := rtmath.Point(x, 0.4, 0.7)
+		res, err := PatternAt(p, point)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := PatternAt(stripe, point)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, expected) {
+			t.Errorf("Expected %v at %v to be %v", res, point, expected)
+		}
+	}
+}
+
+func TestPerturbedPatternMovesEdges(t *testing.T) {
+	stripe := NewStripePattern(white, black)
+	p := NewPerturbedPattern(stripe, 0.5, 3)
+	differ := 0
+	for x := -2.; x < 2; x += 0.13 {
+		point := rtmath.Point(x, 0.4, 0.7)
+		res, err := PatternAt(p, point)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := PatternAt(stripe, point)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, white) && !canvas.ColorEqual(res, black) {
+			t.Errorf("Expected %v to be one of the stripe colors", res)
+		}
+		if !canvas.ColorEqual(res, expected) {
+			differ++
+		}
+	}
+	if differ == 0 {
+		t.Errorf("Expected perturbing to change some of the stripes")
+	}
+}
+
+func TestTurbulencePattern(t *testing.T) {
+	p := NewTurbulencePattern(white, black, 4)
+	// Noise is 0 at every whole-numbered point
+	res, err := PatternAt(p, rtmath.Point(1, 2, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !canvas.ColorEqual(res, white) {
+		t.Errorf("Expected %v to be %v", res, white)
+	}
+
+	res, err = PatternAt(p, rtmath.Point(0.3, 1.7, -2.2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := Turbulence(rtmath.Point(0.3, 1.7, -2.2), 4)
+	expected := canvas.NewColor(1-f, 1-f, 1-f)
+	if !canvas.ColorEqual(res, expected) {
+		t.Errorf("Expected %v to be %v", res, expected)
+	}
+}
+
+func TestNoisePresetsUseTheirColors(t *testing.T) {
+	a := canvas.NewColor(0.9, 0.9, 0.85)
+	b := canvas.NewColor(0.3, 0.3, 0.35)
+	for _, p := range []Pattern{NewMarblePattern(a, b), NewWoodPattern(a, b)} {
+		seen := map[canvas.Color]bool{}
+		for x := -1.; x < 1; x += 0.07 {
+			res, err := PatternAt(p, rtmath.Point(x, 0.3, x/2))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !canvas.ColorEqual(res, a) && !canvas.ColorEqual(res, b) {
+				t.Errorf("Expected %v to be %v or %v", res, a, b)
+			}
+			seen[res] = true
+		}
+		if len(seen) != 2 {
+			t.Errorf("Expected %T to use both colors but got %v", p, seen)
+		}
+	}
+}
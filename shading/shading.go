@@ -0,0 +1,122 @@
+package shading
+
+import (
+	"math"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+// Refractive indices of common materials
+const (
+	REFRACTIVE_INDEX_VACUUM  = 1.0
+	REFRACTIVE_INDEX_AIR     = 1.00029
+	REFRACTIVE_INDEX_WATER   = 1.333
+	REFRACTIVE_INDEX_GLASS   = 1.5
+	REFRACTIVE_INDEX_DIAMOND = 2.417
+)
+
+type Material struct {
+	Color     canvas.Color
+	Ambient   float64
+	Diffuse   float64
+	Specular  float64
+	Shininess float64
+	// 0 is not reflective at all, 1 is a perfect mirror
+	Reflective float64
+	// 0 is opaque, 1 lets all light through
+	Transparency float64
+	// How much light bends entering the material, 1 is a vacuum
+	RefractiveIndex float64
+	// Used instead of Color when set
+	Pattern Pattern
+}
+
+func NewMaterial() Material {
+	return Material{canvas.NewColor(1, 1, 1), 0.1, 0.9, 0.9, 200., 0, 0, REFRACTIVE_INDEX_VACUUM, nil}
+}
+
+// objectPoint is point in the object space of the shape being lit, patterns
+// are looked up with it. intensity is how much of the light reaches point,
+// 0 when it is in shadow and 1 when it is fully lit. Diffuse and specular are
+// averaged over every sample of the light.
+func Lighting(material Material,
+	objectPoint rtmath.Tuple,
+	light Light,
+	point rtmath.Tuple,
+	eyeV rtmath.Tuple,
+	normalV rtmath.Tuple,
+	intensity float64,
+) (canvas.Color, error) {
+	return lighting(material,
+		objectPoint,
+		light.GetIntensity(),
+		light.LightSamples(point),
+		point,
+		eyeV,
+		normalV,
+		intensity*light.FalloffAt(point))
+}
+
+func lighting(material Material,
+	objectPoint rtmath.Tuple,
+	lightIntensity canvas.Color,
+	samples []LightSample,
+	point rtmath.Tuple,
+	eyeV rtmath.Tuple,
+	normalV rtmath.Tuple,
+	intensity float64,
+) (canvas.Color, error) {
+	color := material.Color
+	if material.Pattern != nil {
+		var err error
+		color, err = PatternAt(material.Pattern, objectPoint)
+		if err != nil {
+			return canvas.Color{}, err
+		}
+	}
+
+	// Blend surface color with light's color
+	effectiveColor := canvas.ColorBlend(color, lightIntensity)
+
+	// Compute ambient contribution
+	ambient := canvas.ColorScale(effectiveColor, material.Ambient)
+
+	// If fully in shadow, ignore specular and diffuse
+	if intensity <= 0 {
+		return ambient, nil
+	}
+
+	sum := canvas.NewColor(0, 0, 0)
+	for _, sample := range samples {
+		lightV := sample.Direction
+
+		// LightDotNormal: Cos of angle between light vector and normal
+		// Negative means light on other side of surface, so just ambient, no diffuse and specular
+		lightDotNormal := rtmath.VectorDot(lightV, normalV)
+		if lightDotNormal < 0 {
+			continue
+		}
+
+		// Compute diffuse contribution
+		diffuse := canvas.ColorScale(effectiveColor, material.Diffuse*lightDotNormal)
+		sum = canvas.ColorAdd(sum, diffuse)
+
+		// ReflectDotEye: Cos of angle between reflection vector and eye vector
+		// Negative means light reflects away from eye
+		// So no specular, just ambient and diffuse
+		reflectV := rtmath.VectorNormalReflect(rtmath.TupleNegate(lightV), normalV)
+		reflectDotEye := rtmath.VectorDot(reflectV, eyeV)
+		if reflectDotEye <= 0 {
+			continue
+		}
+
+		// Here, compute specular contribution
+		factor := math.Pow(reflectDotEye, material.Shininess)
+		specular := canvas.ColorScale(lightIntensity, material.Specular*factor)
+		sum = canvas.ColorAdd(sum, specular)
+	}
+
+	lit := canvas.ColorScale(sum, intensity/float64(len(samples)))
+	return canvas.ColorAdd(ambient, lit), nil
+}
@@ -0,0 +1,248 @@
+package shading
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestDefaultMaterial(t *testing.T) {
+	m := NewMaterial()
+	if !canvas.ColorEqual(m.Color, canvas.NewColor(1, 1, 1)) ||
+		!rtmath.FloatEqual(m.Ambient, 0.1) ||
+		!rtmath.FloatEqual(m.Diffuse, 0.9) ||
+		!rtmath.FloatEqual(m.Specular, 0.9) ||
+		!rtmath.FloatEqual(m.Shininess, 200.) ||
+		!rtmath.FloatEqual(m.Reflective, 0) ||
+		!rtmath.FloatEqual(m.Transparency, 0) ||
+		!rtmath.FloatEqual(m.RefractiveIndex, 1) {
+		t.Errorf("Default material is incorrect %v", m)
+	}
+}
+
+func lightingBackground() (Material, rtmath.Tuple) {
+	return NewMaterial(), rtmath.Point(0, 0, 0)
+}
+
+// Light Eye Surface
+func TestLightingEyeBetweenLightAndSurface(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 0, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(1.9, 1.9, 1.9)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+// --------Eye
+//
+// Light      Surface
+func TestLightingEyeBetweenLightAndSurfaceEyeOffset45Degrees(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, math.Sqrt2/2, -math.Sqrt2/2)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 0, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(1.0, 1.0, 1.0)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+// --------Light
+//
+// Eye           Surface
+func TestLightingEyeOppositeSurfaceLightOffset45Degrees(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 10, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(0.7364, 0.7364, 0.7364)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+// --------Light
+//
+// ----------------Surface
+//
+// --------Eye
+func TestLightingEyeInPathOfReflection(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, -math.Sqrt2/2, -math.Sqrt2/2)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 10, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(1.6364, 1.6364, 1.6364)
+
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+// Light Surface Eye
+func TestLightingLightBehindSurface(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 0, 10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(0.1, 0.1, 0.1)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestLightingWithSurfaceInShadow(t *testing.T) {
+	m, p := lightingBackground()
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 0, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Lighting(m, p, light, p, eyeV, normalV, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := canvas.NewColor(0.1, 0.1, 0.1)
+	if !canvas.ColorEqual(res, expect) {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestLightingWithPatternApplied(t *testing.T) {
+	m := NewMaterial()
+	m.Pattern = NewStripePattern(canvas.NewColor(1, 1, 1), canvas.NewColor(0, 0, 0))
+	m.Ambient = 1
+	m.Diffuse = 0
+	m.Specular = 0
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 0, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		point    rtmath.Tuple
+		expected canvas.Color
+	}
+	cases := []testCase{
+		{rtmath.Point(0.9, 0, 0), canvas.NewColor(1, 1, 1)},
+		{rtmath.Point(1.1, 0, 0), canvas.NewColor(0, 0, 0)},
+	}
+	for _, c := range cases {
+		res, err := Lighting(m, c.point, light, c.point, eyeV, normalV, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v to be %v", res, c.expected)
+		}
+	}
+}
+
+func TestLightingUsesIntensityToAttenuateColor(t *testing.T) {
+	m := NewMaterial()
+	m.Ambient = 0.1
+	m.Diffuse = 0.9
+	m.Specular = 0
+	p := rtmath.Point(0, 0, -1)
+	eyeV := rtmath.Vector(0, 0, -1)
+	normalV := rtmath.Vector(0, 0, -1)
+	light, err := NewPointLight(rtmath.Point(0, 0, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		intensity float64
+		expected  canvas.Color
+	}
+	cases := []testCase{
+		{1.0, canvas.NewColor(1, 1, 1)},
+		{0.5, canvas.NewColor(0.55, 0.55, 0.55)},
+		{0.0, canvas.NewColor(0.1, 0.1, 0.1)},
+	}
+	for _, c := range cases {
+		res, err := Lighting(m, p, light, p, eyeV, normalV, c.intensity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v to be %v", res, c.expected)
+		}
+	}
+}
+
+func TestAreaLightingSamplesTheLight(t *testing.T) {
+	light, err := NewAreaLight(rtmath.Point(-0.5, -0.5, -5), rtmath.Vector(1, 0, 0), 2, rtmath.Vector(0, 1, 0), 2, canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	light.Jitter = false
+	m := NewMaterial()
+	m.Ambient = 0.1
+	m.Diffuse = 0.9
+	m.Specular = 0
+	eye := rtmath.Point(0, 0, -5)
+
+	type testCase struct {
+		point    rtmath.Tuple
+		expected canvas.Color
+	}
+	cases := []testCase{
+		{rtmath.Point(0, 0, -1), canvas.NewColor(0.9965, 0.9965, 0.9965)},
+		{rtmath.Point(0, 0.7071, -0.7071), canvas.NewColor(0.62318, 0.62318, 0.62318)},
+	}
+	for _, c := range cases {
+		eyeV := rtmath.VectorNormalize(rtmath.TupleSubtract(eye, c.point))
+		// The point is on a unit sphere, so its normal is the point itself
+		normalV := rtmath.Vector(c.point.X, c.point.Y, c.point.Z)
+		res, err := Lighting(m, c.point, light, c.point, eyeV, normalV, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v to be %v", res, c.expected)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package shading
+
+import (
+	"math"
+
+	"ray-tracer-challenge/canvas"
+)
+
+type TextureFilter int
+
+const (
+	// Uses the closest pixel
+	TEXTURE_FILTER_NEAREST TextureFilter = iota
+	// Blends the four surrounding pixels
+	TEXTURE_FILTER_BILINEAR
+)
+
+// Samples an image, u runs left to right and v bottom to top
+type ImageTexture struct {
+	Canvas canvas.Canvas
+	Filter TextureFilter
+}
+
+func NewImageTexture(c canvas.Canvas, filter TextureFilter) *ImageTexture {
+	return &ImageTexture{c, filter}
+}
+
+func (t *ImageTexture) UVPatternAt(u float64, v float64) canvas.Color {
+	u = clamp(u, 0, 1)
+	// Rows of the canvas go from top to bottom
+	v = 1 - clamp(v, 0, 1)
+	x := u * float64(t.Canvas.Width-1)
+	y := v * float64(t.Canvas.Height-1)
+
+	if t.Filter == TEXTURE_FILTER_NEAREST {
+		return canvas.PixelAt(t.Canvas, int64(math.Round(x)), int64(math.Round(y)))
+	}
+
+	x0, y0 := math.Floor(x), math.Floor(y)
+	x1 := math.Min(x0+1, float64(t.Canvas.Width-1))
+	y1 := math.Min(y0+1, float64(t.Canvas.Height-1))
+	fx, fy := x-x0, y-y0
+
+	top := canvas.ColorAdd(
+		canvas.ColorScale(canvas.PixelAt(t.Canvas, int64(x0), int64(y0)), 1-fx),
+		canvas.ColorScale(canvas.PixelAt(t.Canvas, int64(x1), int64(y0)), fx))
+	bottom := canvas.ColorAdd(
+		canvas.ColorScale(canvas.PixelAt(t.Canvas, int64(x0), int64(y1)), 1-fx),
+		canvas.ColorScale(canvas.PixelAt(t.Canvas, int64(x1), int64(y1)), fx))
+	return canvas.ColorAdd(canvas.ColorScale(top, 1-fy), canvas.ColorScale(bottom, fy))
+}
+
+func clamp(f float64, min float64, max float64) float64 {
+	return math.Max(min, math.Min(max, f))
+}
@@ -0,0 +1,61 @@
+package shading
+
+import (
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+)
+
+func textureCanvas() canvas.Canvas {
+	// Black and white on the bottom row, red and green on the top
+	c := canvas.NewCanvas(2, 2)
+	canvas.WritePixel(c, 0, 0, canvas.NewColor(1, 0, 0))
+	canvas.WritePixel(c, 1, 0, canvas.NewColor(0, 1, 0))
+	canvas.WritePixel(c, 0, 1, black)
+	canvas.WritePixel(c, 1, 1, white)
+	return c
+}
+
+func TestImageTexture(t *testing.T) {
+	nearest := NewImageTexture(textureCanvas(), TEXTURE_FILTER_NEAREST)
+	bilinear := NewImageTexture(textureCanvas(), TEXTURE_FILTER_BILINEAR)
+
+	type testCase struct {
+		texture  *ImageTexture
+		u        float64
+		v        float64
+		expected canvas.Color
+	}
+	cases := []testCase{
+		{nearest, 0, 0, black},
+		{nearest, 1, 0, white},
+		{nearest, 0, 1, canvas.NewColor(1, 0, 0)},
+		{nearest, 1, 1, canvas.NewColor(0, 1, 0)},
+		{nearest, 0.4, 0.6, canvas.NewColor(1, 0, 0)},
+		// Out of range coordinates are clamped to the edge
+		{nearest, -0.5, 1.5, canvas.NewColor(1, 0, 0)},
+		{bilinear, 0, 0, black},
+		{bilinear, 1, 1, canvas.NewColor(0, 1, 0)},
+		{bilinear, 0.5, 0, canvas.NewColor(0.5, 0.5, 0.5)},
+		{bilinear, 0, 0.5, canvas.NewColor(0.5, 0, 0)},
+		{bilinear, 0.5, 0.5, canvas.NewColor(0.5, 0.5, 0.25)},
+		{bilinear, 0.25, 1, canvas.NewColor(0.75, 0.25, 0)},
+	}
+	for _, c := range cases {
+		res := c.texture.UVPatternAt(c.u, c.v)
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v at %v, %v to be %v", res, c.u, c.v, c.expected)
+		}
+	}
+}
+
+func TestImageTextureOnSingleRowCanvas(t *testing.T) {
+	c := canvas.NewCanvas(2, 1)
+	canvas.WritePixel(c, 1, 0, white)
+	tex := NewImageTexture(c, TEXTURE_FILTER_BILINEAR)
+	res := tex.UVPatternAt(0.5, 0.3)
+	expected := canvas.NewColor(0.5, 0.5, 0.5)
+	if !canvas.ColorEqual(res, expected) {
+		t.Errorf("Expected %v to be %v", res, expected)
+	}
+}
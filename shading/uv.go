@@ -0,0 +1,154 @@
+package shading
+
+import (
+	"math"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+// UVPattern colors a flat surface by its u and v coordinates, both 0..1
+type UVPattern interface {
+	UVPatternAt(u float64, v float64) canvas.Color
+}
+
+// UVMapping flattens a point on a 3D surface to u and v coordinates
+type UVMapping func(p rtmath.Tuple) (float64, float64)
+
+// Checkers with width squares across u and height squares across v
+type UVCheckers struct {
+	Width  float64
+	Height float64
+	A      canvas.Color
+	B      canvas.Color
+}
+
+func NewUVCheckers(width float64, height float64, a canvas.Color, b canvas.Color) *UVCheckers {
+	return &UVCheckers{width, height, a, b}
+}
+
+func (c *UVCheckers) UVPatternAt(u float64, v float64) canvas.Color {
+	if isEven(math.Floor(u*c.Width) + math.Floor(v*c.Height)) {
+		return c.A
+	}
+	return c.B
+}
+
+// Wraps a UVPattern around a surface using a UVMapping
+type TextureMapPattern struct {
+	BasePattern
+	UVPattern UVPattern
+	Mapping   UVMapping
+}
+
+func NewTextureMapPattern(uvPattern UVPattern, mapping UVMapping) *TextureMapPattern {
+	return &TextureMapPattern{newBasePattern(), uvPattern, mapping}
+}
+
+func (t *TextureMapPattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	u, v := t.Mapping(p)
+	return t.UVPattern.UVPatternAt(u, v), nil
+}
+
+// Maps a sphere centered at the origin, u goes around the y axis and v from
+// the south to the north pole
+func SphericalMap(p rtmath.Tuple) (float64, float64) {
+	theta := math.Atan2(p.X, p.Z)
+	radius := rtmath.VectorMagnitude(rtmath.Vector(p.X, p.Y, p.Z))
+	phi := math.Acos(p.Y / radius)
+
+	rawU := theta / (2 * math.Pi)
+	u := 1 - (rawU + 0.5)
+	v := 1 - phi/math.Pi
+	return u, v
+}
+
+// Maps the xz plane, repeating every unit
+func PlanarMap(p rtmath.Tuple) (float64, float64) {
+	return positiveMod(p.X, 1), positiveMod(p.Z, 1)
+}
+
+// Maps a unit cylinder around the y axis, v repeats every unit of height
+func CylindricalMap(p rtmath.Tuple) (float64, float64) {
+	theta := math.Atan2(p.X, p.Z)
+	rawU := theta / (2 * math.Pi)
+	u := 1 - (rawU + 0.5)
+	return u, positiveMod(p.Y, 1)
+}
+
+type CubeFace int
+
+const (
+	CUBE_FACE_LEFT CubeFace = iota
+	CUBE_FACE_RIGHT
+	CUBE_FACE_FRONT
+	CUBE_FACE_BACK
+	CUBE_FACE_UP
+	CUBE_FACE_DOWN
+)
+
+// Picks the face of a cube centered at the origin that p lies on
+func CubeFaceFromPoint(p rtmath.Tuple) CubeFace {
+	absX, absY, absZ := math.Abs(p.X), math.Abs(p.Y), math.Abs(p.Z)
+	coord := math.Max(absX, math.Max(absY, absZ))
+
+	switch coord {
+	case p.X:
+		return CUBE_FACE_RIGHT
+	case -p.X:
+		return CUBE_FACE_LEFT
+	case p.Y:
+		return CUBE_FACE_UP
+	case -p.Y:
+		return CUBE_FACE_DOWN
+	case p.Z:
+		return CUBE_FACE_FRONT
+	}
+	return CUBE_FACE_BACK
+}
+
+// Maps p to u and v on its own face of a -1..1 cube, each face is seen
+// upright from outside the cube
+func CubeMap(p rtmath.Tuple) (CubeFace, float64, float64) {
+	face := CubeFaceFromPoint(p)
+	var u, v float64
+	switch face {
+	case CUBE_FACE_FRONT:
+		u, v = positiveMod(p.X+1, 2)/2, positiveMod(p.Y+1, 2)/2
+	case CUBE_FACE_BACK:
+		u, v = positiveMod(1-p.X, 2)/2, positiveMod(p.Y+1, 2)/2
+	case CUBE_FACE_LEFT:
+		u, v = positiveMod(p.Z+1, 2)/2, positiveMod(p.Y+1, 2)/2
+	case CUBE_FACE_RIGHT:
+		u, v = positiveMod(1-p.Z, 2)/2, positiveMod(p.Y+1, 2)/2
+	case CUBE_FACE_UP:
+		u, v = positiveMod(p.X+1, 2)/2, positiveMod(1-p.Z, 2)/2
+	case CUBE_FACE_DOWN:
+		u, v = positiveMod(p.X+1, 2)/2, positiveMod(p.Z+1, 2)/2
+	}
+	return face, u, v
+}
+
+// Gives each face of a cube its own UVPattern, indexed by CubeFace
+type CubeMapPattern struct {
+	BasePattern
+	Faces [6]UVPattern
+}
+
+func NewCubeMapPattern(left UVPattern, right UVPattern, front UVPattern, back UVPattern, up UVPattern, down UVPattern) *CubeMapPattern {
+	return &CubeMapPattern{newBasePattern(), [6]UVPattern{left, right, front, back, up, down}}
+}
+
+func (c *CubeMapPattern) LocalPatternAt(p rtmath.Tuple) (canvas.Color, error) {
+	face, u, v := CubeMap(p)
+	return c.Faces[face].UVPatternAt(u, v), nil
+}
+
+// Like math.Mod but always between 0 and m
+func positiveMod(f float64, m float64) float64 {
+	r := math.Mod(f, m)
+	if r < 0 {
+		r += m
+	}
+	return r
+}
@@ -0,0 +1,187 @@
+package shading
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestUVCheckers(t *testing.T) {
+	checkers := NewUVCheckers(2, 2, black, white)
+
+	type testCase struct {
+		u        float64
+		v        float64
+		expected canvas.Color
+	}
+	cases := []testCase{
+		{0.0, 0.0, black},
+		{0.5, 0.0, white},
+		{0.0, 0.5, white},
+		{0.5, 0.5, black},
+		{1.0, 1.0, black},
+	}
+	for _, c := range cases {
+		res := checkers.UVPatternAt(c.u, c.v)
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v at %v, %v to be %v", res, c.u, c.v, c.expected)
+		}
+	}
+}
+
+func TestUVMappings(t *testing.T) {
+	type testCase struct {
+		mapping UVMapping
+		point   rtmath.Tuple
+		u       float64
+		v       float64
+	}
+	cases := []testCase{
+		{SphericalMap, rtmath.Point(0, 0, -1), 0.0, 0.5},
+		{SphericalMap, rtmath.Point(1, 0, 0), 0.25, 0.5},
+		{SphericalMap, rtmath.Point(0, 0, 1), 0.5, 0.5},
+		{SphericalMap, rtmath.Point(-1, 0, 0), 0.75, 0.5},
+		{SphericalMap, rtmath.Point(0, 1, 0), 0.5, 1.0},
+		{SphericalMap, rtmath.Point(0, -1, 0), 0.5, 0.0},
+		{SphericalMap, rtmath.Point(math.Sqrt2/2, math.Sqrt2/2, 0), 0.25, 0.75},
+		{PlanarMap, rtmath.Point(0.25, 0, 0.5), 0.25, 0.5},
+		{PlanarMap, rtmath.Point(0.25, 0, -0.25), 0.25, 0.75},
+		{PlanarMap, rtmath.Point(0.25, 0.5, -0.25), 0.25, 0.75},
+		{PlanarMap, rtmath.Point(1.25, 0, 0.5), 0.25, 0.5},
+		{PlanarMap, rtmath.Point(0.25, 0, -1.75), 0.25, 0.25},
+		{PlanarMap, rtmath.Point(1, 0, -1), 0.0, 0.0},
+		{PlanarMap, rtmath.Point(0, 0, 0), 0.0, 0.0},
+		{CylindricalMap, rtmath.Point(0, 0, -1), 0.0, 0.0},
+		{CylindricalMap, rtmath.Point(0, 0.5, -1), 0.0, 0.5},
+		{CylindricalMap, rtmath.Point(0, 1, -1), 0.0, 0.0},
+		{CylindricalMap, rtmath.Point(0.70711, 0.5, -0.70711), 0.125, 0.5},
+		{CylindricalMap, rtmath.Point(1, 0.5, 0), 0.25, 0.5},
+		{CylindricalMap, rtmath.Point(0.70711, 0.5, 0.70711), 0.375, 0.5},
+		{CylindricalMap, rtmath.Point(0, -0.25, 1), 0.5, 0.75},
+		{CylindricalMap, rtmath.Point(-0.70711, 0.5, 0.70711), 0.625, 0.5},
+		{CylindricalMap, rtmath.Point(-1, 1.25, 0), 0.75, 0.25},
+		{CylindricalMap, rtmath.Point(-0.70711, 0.5, -0.70711), 0.875, 0.5},
+	}
+	for _, c := range cases {
+		u, v := c.mapping(c.point)
+		if !rtmath.FloatEqual(u, c.u) || !rtmath.FloatEqual(v, c.v) {
+			t.Errorf("Expected %v to map to %v, %v but got %v, %v", c.point, c.u, c.v, u, v)
+		}
+	}
+}
+
+func TestTextureMapPatternWithSphericalMap(t *testing.T) {
+	p := NewTextureMapPattern(NewUVCheckers(16, 8, black, white), SphericalMap)
+
+	type testCase struct {
+		point    rtmath.Tuple
+		expected canvas.Color
+	}
+	cases := []testCase{
+		{rtmath.Point(0.4315, 0.4670, 0.7719), white},
+		{rtmath.Point(-0.9654, 0.2552, -0.0534), black},
+		{rtmath.Point(0.1039, 0.7090, 0.6975), white},
+		{rtmath.Point(-0.4986, -0.7856, -0.3663), black},
+		{rtmath.Point(-0.0317, -0.9395, 0.3411), black},
+		{rtmath.Point(0.4809, -0.7721, 0.4154), black},
+		{rtmath.Point(0.0285, -0.9612, -0.2745), black},
+		{rtmath.Point(-0.5734, -0.2162, -0.7903), white},
+		{rtmath.Point(0.7688, -0.1470, 0.6223), black},
+		{rtmath.Point(-0.7652, 0.2175, 0.6060), black},
+	}
+	for _, c := range cases {
+		res, err := PatternAt(p, c.point)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v at %v to be %v", res, c.point, c.expected)
+		}
+	}
+}
+
+func TestCubeFaceFromPoint(t *testing.T) {
+	type testCase struct {
+		point rtmath.Tuple
+		face  CubeFace
+	}
+	cases := []testCase{
+		{rtmath.Point(-1, 0.5, -0.25), CUBE_FACE_LEFT},
+		{rtmath.Point(1.1, -0.75, 0.8), CUBE_FACE_RIGHT},
+		{rtmath.Point(0.1, 0.6, 0.9), CUBE_FACE_FRONT},
+		{rtmath.Point(-0.7, 0, -2), CUBE_FACE_BACK},
+		{rtmath.Point(0.5, 1, 0.9), CUBE_FACE_UP},
+		{rtmath.Point(-0.2, -1.3, 1.1), CUBE_FACE_DOWN},
+	}
+	for _, c := range cases {
+		face := CubeFaceFromPoint(c.point)
+		if face != c.face {
+			t.Errorf("Expected %v to be on face %v but got %v", c.point, c.face, face)
+		}
+	}
+}
+
+func TestCubeMap(t *testing.T) {
+	type testCase struct {
+		point rtmath.Tuple
+		u     float64
+		v     float64
+	}
+	cases := []testCase{
+		{rtmath.Point(-0.5, 0.5, 1), 0.25, 0.75},
+		{rtmath.Point(0.5, -0.5, 1), 0.75, 0.25},
+		{rtmath.Point(0.5, 0.5, -1), 0.25, 0.75},
+		{rtmath.Point(-0.5, -0.5, -1), 0.75, 0.25},
+		{rtmath.Point(-1, 0.5, -0.5), 0.25, 0.75},
+		{rtmath.Point(-1, -0.5, 0.5), 0.75, 0.25},
+		{rtmath.Point(1, 0.5, 0.5), 0.25, 0.75},
+		{rtmath.Point(1, -0.5, -0.5), 0.75, 0.25},
+		{rtmath.Point(-0.5, 1, -0.5), 0.25, 0.75},
+		{rtmath.Point(0.5, 1, 0.5), 0.75, 0.25},
+		{rtmath.Point(-0.5, -1, 0.5), 0.25, 0.75},
+		{rtmath.Point(0.5, -1, -0.5), 0.75, 0.25},
+	}
+	for _, c := range cases {
+		_, u, v := CubeMap(c.point)
+		if !rtmath.FloatEqual(u, c.u) || !rtmath.FloatEqual(v, c.v) {
+			t.Errorf("Expected %v to map to %v, %v but got %v, %v", c.point, c.u, c.v, u, v)
+		}
+	}
+}
+
+func TestCubeMapPatternUsesEachFace(t *testing.T) {
+	colors := []canvas.Color{
+		canvas.NewColor(1, 0, 0),
+		canvas.NewColor(0, 1, 0),
+		canvas.NewColor(0, 0, 1),
+		canvas.NewColor(1, 1, 0),
+		canvas.NewColor(0, 1, 1),
+		canvas.NewColor(1, 0, 1),
+	}
+	faces := []UVPattern{}
+	for _, c := range colors {
+		faces = append(faces, NewUVCheckers(2, 2, c, white))
+	}
+	p := NewCubeMapPattern(faces[0], faces[1], faces[2], faces[3], faces[4], faces[5])
+
+	// Each point lands in the lower left square of its face
+	points := []rtmath.Tuple{
+		rtmath.Point(-1, -0.5, -0.5),
+		rtmath.Point(1, -0.5, 0.5),
+		rtmath.Point(-0.5, -0.5, 1),
+		rtmath.Point(0.5, -0.5, -1),
+		rtmath.Point(-0.5, 1, 0.5),
+		rtmath.Point(-0.5, -1, -0.5),
+	}
+	for i, point := range points {
+		res, err := PatternAt(p, point)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, colors[i]) {
+			t.Errorf("Expected %v at %v to be %v", res, point, colors[i])
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package world
+
+import (
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+// Builds a latitude/longitude sphere out of 2 * segments * segments triangles
+func tessellatedSphere(segments int) *geometry.Group {
+	g := geometry.NewGroup()
+	at := func(i int, j int) rtmath.Tuple {
+		theta := math.Pi * float64(i) / float64(segments)
+		phi := 2 * math.Pi * float64(j) / float64(segments)
+		return rtmath.Point(math.Sin(theta)*math.Cos(phi), math.Cos(theta), math.Sin(theta)*math.Sin(phi))
+	}
+	for i := 0; i < segments; i++ {
+		for j := 0; j < segments; j++ {
+			g.AddChild(geometry.NewTriangle(at(i, j), at(i+1, j), at(i+1, j+1)))
+			g.AddChild(geometry.NewTriangle(at(i, j), at(i+1, j+1), at(i, j+1)))
+		}
+	}
+	return g
+}
+
+func meshWorld(segments int) (World, error) {
+	l, err := shading.NewPointLight(rtmath.Point(-10, 10, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		return World{}, err
+	}
+	mesh := tessellatedSphere(segments)
+	mesh.Transform = rtmath.Translation(0, 1, 0)
+	floor := geometry.NewPlane()
+	return World{Objects: []geometry.Shape{floor, mesh}, Lights: []shading.Light{l}, MaxDepth: DEFAULT_MAX_DEPTH}, nil
+}
+
+func meshRays(n int) ([]geometry.Ray, error) {
+	rays := []geometry.Ray{}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			x := -1.5 + 3*float64(i)/float64(n)
+			y := -0.5 + 3*float64(j)/float64(n)
+			r, err := geometry.NewRay(rtmath.Point(0, 1, -5), rtmath.VectorNormalize(rtmath.Vector(x, y-1, 5)))
+			if err != nil {
+				return nil, err
+			}
+			rays = append(rays, r)
+		}
+	}
+	return rays, nil
+}
+
+func TestWorldRayIntersectWithBVH(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = buildWorldBVH(&w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := WorldRayIntersect(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 4 ||
+		!rtmath.FloatEqual(xs[0].T, 4) ||
+		!rtmath.FloatEqual(xs[1].T, 4.5) ||
+		!rtmath.FloatEqual(xs[2].T, 5.5) ||
+		!rtmath.FloatEqual(xs[3].T, 6) {
+		t.Errorf("Expected ts to be [4,4.5,5.5,6] but intersection array is %v", xs)
+	}
+}
+
+func TestRenderSeesShapesMovedInPlace(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCamera(11, 11, math.Pi/2)
+	tr, err := ViewTransform(rtmath.Point(0, 0, -5), rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+	before, err := Render(c, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Animating moves the shapes themselves rather than changing Objects
+	for _, s := range w.Objects {
+		s.(*geometry.Sphere).Transform = rtmath.Translation(100, 0, 0)
+	}
+	after, err := Render(c, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	black := canvas.NewColor(0, 0, 0)
+	if canvas.ColorEqual(canvas.PixelAt(before, 5, 5), black) || !canvas.ColorEqual(canvas.PixelAt(after, 5, 5), black) {
+		t.Errorf("Expected the spheres to be gone from the center but got %v then %v", canvas.PixelAt(before, 5, 5), canvas.PixelAt(after, 5, 5))
+	}
+
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := WorldRayIntersect(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 0 {
+		t.Errorf("Expected the moved spheres to be missed but got %v", xs)
+	}
+}
+
+func TestColorAtWithBVHMatchesLinearScan(t *testing.T) {
+	w, err := meshWorld(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withBVH := w
+	err = buildWorldBVH(&withBVH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rays, err := meshRays(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rays {
+		c1, err := ColorAt(w, r, DEFAULT_MAX_DEPTH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c2, err := ColorAt(withBVH, r, DEFAULT_MAX_DEPTH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(c1, c2) {
+			t.Errorf("Expected %v with the BVH to equal %v without it for %v", c2, c1, r)
+		}
+	}
+}
+
+func benchmarkColorAt(b *testing.B, useBVH bool) {
+	// 2 * 70 * 70 = 9800 triangles
+	w, err := meshWorld(70)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if useBVH {
+		err = buildWorldBVH(&w)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	rays, err := meshRays(4)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range rays {
+			_, err := ColorAt(w, r, DEFAULT_MAX_DEPTH)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkColorAtLinearScan(b *testing.B) {
+	benchmarkColorAt(b, false)
+}
+
+func BenchmarkColorAtBVH(b *testing.B) {
+	benchmarkColorAt(b, true)
+}
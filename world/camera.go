@@ -0,0 +1,379 @@
+package world
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sync"
+	"time"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+)
+
+// Width and height of the square tiles Render hands out to workers
+const DEFAULT_TILE_SIZE = 16
+
+type Projection int
+
+const (
+	PROJECTION_PERSPECTIVE Projection = iota
+	// Parallel rays, sizes don't change with distance
+	PROJECTION_ORTHOGRAPHIC
+	// Equidistant, the angle off the view axis grows linearly with the
+	// distance from the center of the image
+	PROJECTION_FISHEYE
+	// Longitude across the image and latitude down it, covering every
+	// direction
+	PROJECTION_EQUIRECTANGULAR
+)
+
+type Camera struct {
+	Transform   rtmath.Matrix
+	HSize       int64
+	VSize       int64
+	FieldOfView float64
+	PixelSize   float64
+	// Half the size of the canvas. World units for perspective and
+	// orthographic cameras, radians for fisheye ones, and unused by
+	// equirectangular ones, which always cover every direction
+	HalfWidth  float64
+	HalfHeight float64
+	// Radius of the lens, 0 is a pinhole camera with everything in focus.
+	// Fisheye and equirectangular cameras are always pinholes
+	Aperture float64
+	// Distance from the camera to the plane that is in perfect focus
+	FocalDistance float64
+	Projection    Projection
+	// Rays are cast at times spread over the shutter interval, so shapes
+	// that move while it is open get blurred. Both 0 freezes everything at
+	// time 0
+	ShutterOpen  float64
+	ShutterClose float64
+}
+
+// Where a single camera ray goes through the canvas and the lens
+type CameraSample struct {
+	// Measured in pixels from the top left of the canvas
+	X float64
+	Y float64
+	// 0..1 across the lens, 0.5, 0.5 is its center
+	LensU float64
+	LensV float64
+	// 0..1 from the shutter opening to it closing
+	Time float64
+}
+
+func ViewTransform(from rtmath.Tuple, to rtmath.Tuple, up rtmath.Tuple) (rtmath.Matrix, error) {
+	forward := rtmath.VectorNormalize(rtmath.TupleSubtract(to, from))
+	upN := rtmath.VectorNormalize(up)
+	left := rtmath.VectorCross(forward, upN)
+	trueUp := rtmath.VectorCross(left, forward)
+	orientation := rtmath.MatrixConstruct([][]float64{
+		{left.X, left.Y, left.Z, 0.},
+		{trueUp.X, trueUp.Y, trueUp.Z, 0},
+		{-forward.X, -forward.Y, -forward.Z, 0},
+		{0, 0, 0, 1},
+	})
+	tr := rtmath.Translation(-from.X, -from.Y, -from.Z)
+	return rtmath.Matrix4x4Multiply(orientation, tr)
+}
+
+func NewCamera(hSize int64, vSize int64, fov float64) Camera {
+	return newCamera(hSize, vSize, fov, math.Tan(fov/2.), PROJECTION_PERSPECTIVE)
+}
+
+// Camera looking at a window width world units across, for technical
+// drawings. Its height follows from the canvas' aspect ratio
+func NewOrthographicCamera(hSize int64, vSize int64, width float64) Camera {
+	// newCamera wants half of the longer side
+	halfView := width / 2.
+	if hSize < vSize {
+		halfView = halfView * float64(vSize) / float64(hSize)
+	}
+	return newCamera(hSize, vSize, 0, halfView, PROJECTION_ORTHOGRAPHIC)
+}
+
+// fov is the angle across the longer side of the canvas, and may be more
+// than Pi
+func NewFisheyeCamera(hSize int64, vSize int64, fov float64) Camera {
+	return newCamera(hSize, vSize, fov, fov/2., PROJECTION_FISHEYE)
+}
+
+// 360 degree panorama, a 2:1 canvas keeps pixels square. The canvas always
+// spans every direction, so PixelSize, HalfWidth and HalfHeight aren't used
+// when rays are cast.
+func NewEquirectangularCamera(hSize int64, vSize int64) Camera {
+	return newCamera(hSize, vSize, 2*math.Pi, math.Pi, PROJECTION_EQUIRECTANGULAR)
+}
+
+// halfView is half the canvas along its longer side
+func newCamera(hSize int64, vSize int64, fov float64, halfView float64, projection Projection) Camera {
+	aspect := float64(hSize) / float64(vSize)
+
+	var hw, hh float64
+	if aspect >= 1 {
+		hw = halfView
+		hh = halfView / aspect
+	} else {
+		hw = halfView * aspect
+		hh = halfView
+	}
+
+	pixelSize := (hw * 2) / float64(hSize)
+
+	return Camera{rtmath.MatrixConstructIdentity(4), hSize, vSize, fov, pixelSize, hw, hh, 0, 1, projection, 0, 0}
+}
+
+// Ray through the center of the pixel and the center of the lens, halfway
+// through the shutter interval
+func RayForPixel(camera Camera, px int64, py int64) (geometry.Ray, error) {
+	return RayForSample(camera, CameraSample{float64(px) + 0.5, float64(py) + 0.5, 0.5, 0.5, 0.5})
+}
+
+func RayForSample(camera Camera, s CameraSample) (geometry.Ray, error) {
+	origin, target := cameraSpaceRay(camera, s)
+
+	// Transform the target and origin with camera matrix
+	// Compute ray's direction vector
+	inv, err := rtmath.MatrixInverse(camera.Transform)
+	if err != nil {
+		return geometry.Ray{}, err
+	}
+	target, err = rtmath.Matrix4x4TupleMultiply(inv, target)
+	if err != nil {
+		return geometry.Ray{}, err
+	}
+	origin, err = rtmath.Matrix4x4TupleMultiply(inv, origin)
+	if err != nil {
+		return geometry.Ray{}, err
+	}
+	direction := rtmath.VectorNormalize(rtmath.TupleSubtract(target, origin))
+	time := camera.ShutterOpen + s.Time*(camera.ShutterClose-camera.ShutterOpen)
+	return geometry.Ray{Origin: origin, Direction: direction, Time: time}, err
+}
+
+// Origin of the ray and a point it passes through, before the camera
+// transform. The camera sits at the origin looking toward -z
+func cameraSpaceRay(camera Camera, s CameraSample) (rtmath.Tuple, rtmath.Tuple) {
+	// Offset from edge of canvas to the point
+	xOffset := s.X * camera.PixelSize
+	yOffset := s.Y * camera.PixelSize
+
+	// Untransformed coords of pixel in camera space
+	// Camera looks toward -z, so +x is left
+	worldX := camera.HalfWidth - xOffset
+	worldY := camera.HalfHeight - yOffset
+
+	switch camera.Projection {
+	case PROJECTION_ORTHOGRAPHIC:
+		// Every ray starts on the z=0 plane and heads straight down -z
+		origin := rtmath.Point(worldX, worldY, 0)
+		if camera.Aperture <= 0 {
+			return origin, rtmath.Point(worldX, worldY, -1)
+		}
+		lx, ly := concentricDisk(s.LensU, s.LensV)
+		lens := rtmath.Point(worldX+lx*camera.Aperture, worldY+ly*camera.Aperture, 0)
+		return lens, rtmath.Point(worldX, worldY, -camera.FocalDistance)
+	case PROJECTION_FISHEYE:
+		// worldX and worldY are angles here, their length is the angle off
+		// the view axis and their direction the way the ray leans
+		theta := math.Hypot(worldX, worldY)
+		phi := math.Atan2(worldY, worldX)
+		return rtmath.Point(0, 0, 0), rtmath.Point(
+			math.Sin(theta)*math.Cos(phi),
+			math.Sin(theta)*math.Sin(phi),
+			-math.Cos(theta),
+		)
+	case PROJECTION_EQUIRECTANGULAR:
+		// Longitude is 0 straight ahead, latitude 0 on the horizon
+		lon := math.Pi * (1 - 2*s.X/float64(camera.HSize))
+		lat := math.Pi / 2 * (1 - 2*s.Y/float64(camera.VSize))
+		return rtmath.Point(0, 0, 0), rtmath.Point(
+			math.Sin(lon)*math.Cos(lat),
+			math.Sin(lat),
+			-math.Cos(lon)*math.Cos(lat),
+		)
+	}
+
+	// Note that canvas at z=-1
+	if camera.Aperture <= 0 {
+		return rtmath.Point(0, 0, 0), rtmath.Point(worldX, worldY, -1)
+	}
+	// Rays from anywhere on the lens meet again on the focal plane, so
+	// only things at FocalDistance are sharp
+	lx, ly := concentricDisk(s.LensU, s.LensV)
+	lens := rtmath.Point(lx*camera.Aperture, ly*camera.Aperture, 0)
+	return lens, rtmath.Point(worldX*camera.FocalDistance, worldY*camera.FocalDistance, -camera.FocalDistance)
+}
+
+// Maps u and v in 0..1 to a point on the unit disk, keeping evenly spread
+// samples evenly spread
+func concentricDisk(u float64, v float64) (float64, float64) {
+	a, b := 2*u-1, 2*v-1
+	if a == 0 && b == 0 {
+		return 0, 0
+	}
+	var r, theta float64
+	if math.Abs(a) > math.Abs(b) {
+		r, theta = a, math.Pi/4*(b/a)
+	} else {
+		r, theta = b, math.Pi/2-math.Pi/4*(a/b)
+	}
+	return r * math.Cos(theta), r * math.Sin(theta)
+}
+
+type RenderOptions struct {
+	// How many tiles are rendered at once, 0 uses runtime.GOMAXPROCS
+	Workers int
+	// 0 uses DEFAULT_TILE_SIZE
+	TileSize int64
+	// Called after each tile is finished, never more than one call at a time
+	Progress func(p RenderProgress)
+	// How many rays each pixel gets, the zero value shoots one through the
+	// center
+	Sampling Sampling
+}
+
+type RenderProgress struct {
+	DonePixels  int64
+	TotalPixels int64
+	DoneTiles   int
+	TotalTiles  int
+	Elapsed     time.Duration
+	// Estimated time left, assuming the remaining tiles take as long on
+	// average as the finished ones
+	Remaining time.Duration
+}
+
+// A rectangle of pixels, from X0, Y0 up to but not including X1, Y1
+type tile struct {
+	X0 int64
+	Y0 int64
+	X1 int64
+	Y1 int64
+}
+
+func Render(camera Camera, world World) (canvas.Canvas, error) {
+	return RenderContext(context.Background(), camera, world, RenderOptions{})
+}
+
+// Splits the image into tiles and renders them on a pool of goroutines.
+// Every pixel is shaded on its own, so the image is the same whatever the
+// number of workers. Rendering stops soon after ctx is done, returning its
+// error.
+func RenderContext(ctx context.Context, camera Camera, world World, opts RenderOptions) (canvas.Canvas, error) {
+	if err := validateSampling(opts.Sampling); err != nil {
+		return canvas.Canvas{}, err
+	}
+	image := canvas.NewCanvas(camera.HSize, camera.VSize)
+	// world is a copy, so this doesn't touch the caller's World, and the BVH
+	// is always built from the shapes as they are now
+	if err := buildWorldBVH(&world); err != nil {
+		return canvas.Canvas{}, err
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	tiles := splitTiles(camera.HSize, camera.VSize, opts.TileSize)
+	if workers > len(tiles) {
+		workers = len(tiles)
+	}
+
+	start := time.Now()
+	progress := RenderProgress{TotalPixels: camera.HSize * camera.VSize, TotalTiles: len(tiles)}
+	var progressMu sync.Mutex
+	report := func(t tile) {
+		if opts.Progress == nil {
+			return
+		}
+		progressMu.Lock()
+		defer progressMu.Unlock()
+		progress.DoneTiles++
+		progress.DonePixels += (t.X1 - t.X0) * (t.Y1 - t.Y0)
+		progress.Elapsed = time.Since(start)
+		left := progress.TotalPixels - progress.DonePixels
+		progress.Remaining = time.Duration(float64(progress.Elapsed) * float64(left) / float64(progress.DonePixels))
+		opts.Progress(progress)
+	}
+
+	queue := make(chan tile)
+	done := make(chan struct{})
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			close(done)
+		})
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range queue {
+				// Each pixel belongs to exactly one tile, so workers never
+				// write to the same part of the canvas
+				if err := renderTile(ctx, camera, world, opts.Sampling, image, t); err != nil {
+					fail(err)
+					return
+				}
+				report(t)
+			}
+		}()
+	}
+
+feed:
+	for _, t := range tiles {
+		select {
+		case queue <- t:
+		case <-done:
+			break feed
+		case <-ctx.Done():
+			fail(ctx.Err())
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr != nil {
+		return canvas.Canvas{}, firstErr
+	}
+	return image, nil
+}
+
+func splitTiles(width int64, height int64, size int64) []tile {
+	if size <= 0 {
+		size = DEFAULT_TILE_SIZE
+	}
+	tiles := []tile{}
+	for y := int64(0); y < height; y += size {
+		for x := int64(0); x < width; x += size {
+			tiles = append(tiles, tile{x, y, min(x+size, width), min(y+size, height)})
+		}
+	}
+	return tiles
+}
+
+func renderTile(ctx context.Context, camera Camera, world World, sampling Sampling, image canvas.Canvas, t tile) error {
+	for y := t.Y0; y < t.Y1; y++ {
+		// Checked every row so a cancel doesn't wait for a whole tile
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for x := t.X0; x < t.X1; x++ {
+			color, err := samplePixel(camera, world, sampling, x, y)
+			if err != nil {
+				return err
+			}
+			canvas.WritePixel(image, x, y, color)
+		}
+	}
+	return nil
+}
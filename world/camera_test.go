@@ -0,0 +1,643 @@
+package world
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+func TestTransformationMatrixForDefaultOrientation(t *testing.T) {
+	tr, err := ViewTransform(rtmath.Point(0, 0, 0), rtmath.Point(0, 0, -1), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.MatrixConstructIdentity(4)
+	if !rtmath.MatrixEqual(tr, expected) {
+		t.Errorf("Expected %v to equal %v", tr, expected)
+	}
+}
+
+func TestTransformationMatrixInPositiveZ(t *testing.T) {
+	tr, err := ViewTransform(rtmath.Point(0, 0, 0), rtmath.Point(0, 0, 1), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Scaling(-1, 1, -1)
+	if !rtmath.MatrixEqual(tr, expected) {
+		t.Errorf("Expected %v to equal %v", tr, expected)
+	}
+}
+
+func TestViewTransformationMovesTheWorld(t *testing.T) {
+	tr, err := ViewTransform(rtmath.Point(0, 0, 8), rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Translation(0, 0, -8)
+	if !rtmath.MatrixEqual(tr, expected) {
+		t.Errorf("Expected %v to equal %v", tr, expected)
+	}
+}
+
+func TestArbitraryViewTransformation(t *testing.T) {
+	tr, err := ViewTransform(rtmath.Point(1, 3, 2), rtmath.Point(4, -2, 8), rtmath.Vector(1, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.MatrixConstruct([][]float64{
+		{-0.50709, 0.50709, 0.67612, -2.36643},
+		{0.76772, 0.60609, 0.12122, -2.82843},
+		{-0.35857, 0.59761, -0.71714, 0.00000},
+		{0.00000, 0.00000, 0.00000, 1.00000},
+	})
+	if !rtmath.MatrixEqual(tr, expected) {
+		t.Errorf("Expected %v to equal %v", tr, expected)
+	}
+}
+
+func TestConstructCamera(t *testing.T) {
+	cam := NewCamera(160, 120, math.Pi/2.)
+	expected := Camera{rtmath.MatrixConstructIdentity(4), 160, 120, math.Pi / 2., -1, -1, -1, 0, 1, PROJECTION_PERSPECTIVE, 0, 0}
+	if cam.HSize != expected.HSize ||
+		cam.VSize != expected.VSize ||
+		!rtmath.FloatEqual(cam.FieldOfView, expected.FieldOfView) ||
+		!rtmath.MatrixEqual(cam.Transform, expected.Transform) {
+		t.Errorf("Expected %v to equal %v", cam, expected)
+	}
+}
+
+func TestPixelSizeForHorizontalCanvas(t *testing.T) {
+	c := NewCamera(200, 125, math.Pi/2.)
+	expected := 0.01
+	if !rtmath.FloatEqual(c.PixelSize, expected) {
+		t.Errorf("Expected %v to equal %v", c.PixelSize, expected)
+	}
+}
+
+func TestPixelSizeForVerticalCanvas(t *testing.T) {
+	c := NewCamera(125, 200, math.Pi/2.)
+	expected := 0.01
+	if !rtmath.FloatEqual(c.PixelSize, expected) {
+		t.Errorf("Expected %v to equal %v", c.PixelSize, expected)
+	}
+}
+
+func TestConstructRayThroughCenterOfTheCanvas(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2.)
+	r, err := RayForPixel(c, 100, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e1 := rtmath.Point(0, 0, 0)
+	if !rtmath.TupleEqual(r.Origin, e1) {
+		t.Errorf("Expected %v to equal %v", r.Origin, e1)
+	}
+	e2 := rtmath.Vector(0, 0, -1)
+	if !rtmath.TupleEqual(r.Direction, e2) {
+		t.Errorf("Expected %v to equal %v", r.Origin, e2)
+	}
+}
+
+func TestConstructRayThroughCornerOfTheCanvas(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2.)
+	r, err := RayForPixel(c, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e1 := rtmath.Point(0, 0, 0)
+	if !rtmath.TupleEqual(r.Origin, e1) {
+		t.Errorf("Expected %v to equal %v", r.Origin, e1)
+	}
+	e2 := rtmath.Vector(0.66519, 0.33259, -0.66851)
+	if !rtmath.TupleEqual(r.Direction, e2) {
+		t.Errorf("Expected %v to equal %v", r.Origin, e2)
+	}
+}
+
+func TestConstructRayWhenCameraIsTransformed(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2.)
+	tr, err := rtmath.Matrix4x4Multiply(rtmath.RotationY(math.Pi/4), rtmath.Translation(0, -2, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+	r, err := RayForPixel(c, 100, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e1 := rtmath.Point(0, 2, -5)
+	if !rtmath.TupleEqual(r.Origin, e1) {
+		t.Errorf("Expected %v to equal %v", r.Origin, e1)
+	}
+	e2 := rtmath.Vector(math.Sqrt2/2., 0, -math.Sqrt2/2.)
+	if !rtmath.TupleEqual(r.Direction, e2) {
+		t.Errorf("Expected %v to equal %v", r.Origin, e2)
+	}
+}
+
+func TestRenderWorldWithCamera(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCamera(11, 11, math.Pi/2.)
+	from := rtmath.Point(0, 0, -5)
+	to := rtmath.Point(0, 0, 0)
+	up := rtmath.Vector(0, 1, 0)
+	tr, err := ViewTransform(from, to, up)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+	image, err := Render(c, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := canvas.PixelAt(image, 5, 5)
+	expected := canvas.NewColor(0.38066, 0.47583, 0.2855)
+	if !canvas.ColorEqual(wanted, expected) {
+		t.Errorf("Expected %v to equal %v", wanted, expected)
+	}
+}
+
+func TestConcentricDisk(t *testing.T) {
+	x, y := concentricDisk(0.5, 0.5)
+	if x != 0 || y != 0 {
+		t.Errorf("Expected the middle to map to the center but got %v, %v", x, y)
+	}
+	for _, corner := range [][2]float64{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+		x, y := concentricDisk(corner[0], corner[1])
+		if !rtmath.FloatEqual(math.Hypot(x, y), 1) {
+			t.Errorf("Expected %v to map onto the rim but got %v, %v", corner, x, y)
+		}
+	}
+	for u := 0.; u <= 1; u += 0.1 {
+		for v := 0.; v <= 1; v += 0.1 {
+			x, y := concentricDisk(u, v)
+			if math.Hypot(x, y) > 1+rtmath.EPSILON {
+				t.Errorf("Expected %v, %v to map inside the disk but got %v, %v", u, v, x, y)
+			}
+		}
+	}
+}
+
+func TestLensRaysMeetOnFocalPlane(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+	c.Aperture = 0.5
+	c.FocalDistance = 5
+	pinhole := NewCamera(201, 101, math.Pi/2)
+
+	for _, point := range [][2]float64{{100.5, 50.5}, {0, 0}, {37.25, 80.5}} {
+		through, err := RayForSample(pinhole, CameraSample{point[0], point[1], 0.5, 0.5, 0.5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Where the pinhole ray crosses the focal plane
+		focus := geometry.RayPosition(through, -c.FocalDistance/through.Direction.Z)
+
+		for _, lens := range [][2]float64{{0.5, 0.5}, {0, 0.5}, {1, 1}, {0.3, 0.8}} {
+			r, err := RayForSample(c, CameraSample{point[0], point[1], lens[0], lens[1], 0.5})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Origin.Z != 0 || math.Hypot(r.Origin.X, r.Origin.Y) > c.Aperture+rtmath.EPSILON {
+				t.Errorf("Expected %v to start on the lens", r.Origin)
+			}
+			res := geometry.RayPosition(r, (-c.FocalDistance-r.Origin.Z)/r.Direction.Z)
+			if !rtmath.TupleEqual(res, focus) {
+				t.Errorf("Expected ray through lens at %v to meet %v but got %v", lens, focus, res)
+			}
+		}
+	}
+}
+
+func TestPinholeIgnoresLensSample(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+	c.FocalDistance = 3
+	expected, err := RayForPixel(c, 100, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := RayForSample(c, CameraSample{100.5, 50.5, 0.1, 0.9, 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rtmath.TupleEqual(r.Origin, expected.Origin) || !rtmath.TupleEqual(r.Direction, expected.Direction) {
+		t.Errorf("Expected %v to be %v", r, expected)
+	}
+}
+
+// Biggest change in green between neighbouring pixels on the middle row,
+// which drops as edges get blurred
+func edgeSharpness(t testing.TB, c Camera, w World, opts RenderOptions) float64 {
+	image, err := RenderContext(context.Background(), c, w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	most := 0.
+	for x := int64(1); x < c.HSize; x++ {
+		a, b := canvas.PixelAt(image, x-1, c.VSize/2), canvas.PixelAt(image, x, c.VSize/2)
+		most = math.Max(most, math.Abs(a.Green-b.Green))
+	}
+	return most
+}
+
+func TestDepthOfFieldBlursOutOfFocusObjects(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCamera(21, 21, math.Pi/4)
+	tr, err := ViewTransform(rtmath.Point(0, 0, -5), rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+	opts := RenderOptions{Sampling: Sampling{Mode: SAMPLE_JITTERED, Grid: 4}}
+
+	inFocus := c
+	inFocus.Aperture = 0.3
+	inFocus.FocalDistance = 4.9
+	outOfFocus := c
+	outOfFocus.Aperture = 0.3
+	outOfFocus.FocalDistance = 1
+	if edgeSharpness(t, outOfFocus, w, opts) >= edgeSharpness(t, inFocus, w, opts) {
+		t.Errorf("Expected the sphere's edge to be softer out of focus")
+	}
+}
+
+func TestRayTimesSpreadOverShutterInterval(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+	c.ShutterOpen = 1
+	c.ShutterClose = 3
+
+	type testCase struct {
+		time     float64
+		expected float64
+	}
+	cases := []testCase{
+		{0, 1},
+		{0.25, 1.5},
+		{1, 3},
+	}
+	for _, tc := range cases {
+		r, err := RayForSample(c, CameraSample{100.5, 50.5, 0.5, 0.5, tc.time})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.FloatEqual(r.Time, tc.expected) {
+			t.Errorf("Expected %v to be %v", r.Time, tc.expected)
+		}
+	}
+
+	r, err := RayForPixel(c, 100, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rtmath.FloatEqual(r.Time, 2) {
+		t.Errorf("Expected %v to be %v", r.Time, 2)
+	}
+}
+
+func TestMotionBlurSmearsMovingObjects(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Objects[0].(*geometry.Sphere).Motion = geometry.LinearMotion(rtmath.Translation(0, 0, 0), rtmath.Translation(1, 0, 0))
+	c := NewCamera(21, 21, math.Pi/4)
+	tr, err := ViewTransform(rtmath.Point(0, 0, -5), rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+	opts := RenderOptions{Sampling: Sampling{Mode: SAMPLE_JITTERED, Grid: 4}}
+
+	// A closed shutter catches the sphere at time 0 only
+	still := c
+	moving := c
+	moving.ShutterClose = 1
+	if edgeSharpness(t, moving, w, opts) >= edgeSharpness(t, still, w, opts) {
+		t.Errorf("Expected the moving sphere's edges to be softer")
+	}
+}
+
+// A small scene that uses patterns, reflection, refraction and a jittered
+// area light, so any ordering dependence in rendering would show
+func busyScene(t testing.TB) (Camera, World) {
+	floor := geometry.NewPlane()
+	floor.Material.Pattern = shading.NewCheckerPattern(canvas.NewColor(1, 1, 1), canvas.NewColor(0.2, 0.2, 0.2))
+	floor.Material.Reflective = 0.3
+	glass := geometry.NewSphere()
+	glass.Transform = rtmath.Translation(0, 1, 0)
+	glass.Material.Transparency = 0.9
+	glass.Material.Reflective = 0.9
+	glass.Material.RefractiveIndex = shading.REFRACTIVE_INDEX_GLASS
+	marble := geometry.NewSphere()
+	marble.Transform = rtmath.Translation(-2, 0.5, 1)
+	marble.Material.Pattern = shading.NewMarblePattern(canvas.NewColor(0.9, 0.9, 0.9), canvas.NewColor(0.2, 0.3, 0.4))
+
+	area, err := shading.NewAreaLight(rtmath.Point(-6, 8, -6), rtmath.Vector(2, 0, 0), 3, rtmath.Vector(0, 2, 0), 3, canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorld()
+	w.Objects = []geometry.Shape{floor, glass, marble}
+	w.Lights = []shading.Light{area}
+
+	c := NewCamera(37, 23, math.Pi/3)
+	tr, err := ViewTransform(rtmath.Point(0, 2, -6), rtmath.Point(0, 1, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+	return c, w
+}
+
+func TestOrthographicRaysAreParallel(t *testing.T) {
+	landscape := NewOrthographicCamera(200, 100, 4)
+	if !rtmath.FloatEqual(landscape.PixelSize, 0.02) {
+		t.Errorf("Expected %v to be %v", landscape.PixelSize, 0.02)
+	}
+	// Still width units across, and taller than it is wide
+	portrait := NewOrthographicCamera(100, 200, 10)
+	if !rtmath.FloatEqual(portrait.HalfWidth, 5) || !rtmath.FloatEqual(portrait.HalfHeight, 10) {
+		t.Errorf("Expected a 10 by 20 window but got %v by %v", 2*portrait.HalfWidth, 2*portrait.HalfHeight)
+	}
+
+	type testCase struct {
+		camera Camera
+		sample CameraSample
+		origin rtmath.Tuple
+	}
+	cases := []testCase{
+		{landscape, CameraSample{100, 50, 0.5, 0.5, 0.5}, rtmath.Point(0, 0, 0)},
+		{landscape, CameraSample{0.5, 0.5, 0.5, 0.5, 0.5}, rtmath.Point(1.99, 0.99, 0)},
+		{landscape, CameraSample{200, 100, 0.5, 0.5, 0.5}, rtmath.Point(-2, -1, 0)},
+		{portrait, CameraSample{50, 100, 0.5, 0.5, 0.5}, rtmath.Point(0, 0, 0)},
+		{portrait, CameraSample{0, 0, 0.5, 0.5, 0.5}, rtmath.Point(5, 10, 0)},
+		{portrait, CameraSample{100, 200, 0.5, 0.5, 0.5}, rtmath.Point(-5, -10, 0)},
+	}
+	for _, tc := range cases {
+		r, err := RayForSample(tc.camera, tc.sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.TupleEqual(r.Origin, tc.origin) {
+			t.Errorf("Expected %v to be %v", r.Origin, tc.origin)
+		}
+		direction := rtmath.Vector(0, 0, -1)
+		if !rtmath.TupleEqual(r.Direction, direction) {
+			t.Errorf("Expected %v to be %v", r.Direction, direction)
+		}
+	}
+}
+
+func TestOrthographicLensRaysMeetOnFocalPlane(t *testing.T) {
+	c := NewOrthographicCamera(200, 100, 4)
+	c.Aperture = 0.5
+	c.FocalDistance = 3
+	focus := rtmath.Point(1.99, 0.99, -3)
+	for _, lens := range [][2]float64{{0, 0.5}, {1, 1}, {0.25, 0.75}} {
+		r, err := RayForSample(c, CameraSample{0.5, 0.5, lens[0], lens[1], 0.5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := geometry.RayPosition(r, -c.FocalDistance/r.Direction.Z)
+		if !rtmath.TupleEqual(p, focus) {
+			t.Errorf("Expected %v to be %v", p, focus)
+		}
+	}
+}
+
+func TestFisheyeAngleGrowsWithDistanceFromCenter(t *testing.T) {
+	c := NewFisheyeCamera(200, 200, math.Pi)
+
+	type testCase struct {
+		sample    CameraSample
+		direction rtmath.Tuple
+	}
+	cases := []testCase{
+		{CameraSample{100, 100, 0.5, 0.5, 0.5}, rtmath.Vector(0, 0, -1)},
+		{CameraSample{0, 100, 0.5, 0.5, 0.5}, rtmath.Vector(1, 0, 0)},
+		{CameraSample{150, 100, 0.5, 0.5, 0.5}, rtmath.Vector(-math.Sqrt2/2, 0, -math.Sqrt2/2)},
+		{CameraSample{100, 0, 0.5, 0.5, 0.5}, rtmath.Vector(0, 1, 0)},
+		// Further out than the edges, a quarter turn along the diagonal
+		{CameraSample{0, 0, 0.5, 0.5, 0.5}, rtmath.Vector(math.Sin(math.Pi/math.Sqrt2)*math.Sqrt2/2, math.Sin(math.Pi/math.Sqrt2)*math.Sqrt2/2, -math.Cos(math.Pi/math.Sqrt2))},
+	}
+	for _, tc := range cases {
+		r, err := RayForSample(c, tc.sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.TupleEqual(r.Origin, rtmath.Point(0, 0, 0)) {
+			t.Errorf("Expected %v to be %v", r.Origin, rtmath.Point(0, 0, 0))
+		}
+		if !rtmath.TupleEqual(r.Direction, tc.direction) {
+			t.Errorf("Expected %v to be %v", r.Direction, tc.direction)
+		}
+	}
+}
+
+func TestEquirectangularCoversEveryDirection(t *testing.T) {
+	c := NewEquirectangularCamera(360, 180)
+	tr, err := rtmath.Transformation(rtmath.Translation(0, 2, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+
+	type testCase struct {
+		sample    CameraSample
+		direction rtmath.Tuple
+	}
+	cases := []testCase{
+		{CameraSample{180, 90, 0.5, 0.5, 0.5}, rtmath.Vector(0, 0, -1)},
+		{CameraSample{90, 90, 0.5, 0.5, 0.5}, rtmath.Vector(1, 0, 0)},
+		{CameraSample{270, 90, 0.5, 0.5, 0.5}, rtmath.Vector(-1, 0, 0)},
+		{CameraSample{0, 90, 0.5, 0.5, 0.5}, rtmath.Vector(0, 0, 1)},
+		{CameraSample{180, 0, 0.5, 0.5, 0.5}, rtmath.Vector(0, 1, 0)},
+		{CameraSample{180, 180, 0.5, 0.5, 0.5}, rtmath.Vector(0, -1, 0)},
+	}
+	for _, tc := range cases {
+		r, err := RayForSample(c, tc.sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Moving the world up leaves the camera below it, still facing -z
+		if !rtmath.TupleEqual(r.Origin, rtmath.Point(0, -2, 0)) {
+			t.Errorf("Expected %v to be %v", r.Origin, rtmath.Point(0, -2, 0))
+		}
+		if !rtmath.TupleEqual(r.Direction, tc.direction) {
+			t.Errorf("Expected %v to be %v", r.Direction, tc.direction)
+		}
+	}
+}
+
+func TestParallelRenderMatchesSerialRender(t *testing.T) {
+	c, w := busyScene(t)
+	serial, err := RenderContext(context.Background(), c, w, RenderOptions{Workers: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.CanvasToPPM(serial)
+
+	for _, opts := range []RenderOptions{{}, {Workers: 8, TileSize: 5}, {Workers: 3, TileSize: 1}, {Workers: 100, TileSize: 64}} {
+		image, err := RenderContext(context.Background(), c, w, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if canvas.CanvasToPPM(image) != expected {
+			t.Errorf("Expected render with %+v to match the serial render", opts)
+		}
+	}
+
+	// Jittered supersampling and lens sampling have to be reproducible too
+	small := NewCamera(15, 9, c.FieldOfView)
+	small.Transform = c.Transform
+	small.Aperture = 0.1
+	small.FocalDistance = 6
+	sampling := Sampling{Mode: SAMPLE_ADAPTIVE, Grid: 3, Filter: FILTER_GAUSSIAN}
+	serial, err = RenderContext(context.Background(), small, w, RenderOptions{Workers: 1, Sampling: sampling})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parallel, err := RenderContext(context.Background(), small, w, RenderOptions{Workers: 4, TileSize: 3, Sampling: sampling})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if canvas.CanvasToPPM(parallel) != canvas.CanvasToPPM(serial) {
+		t.Errorf("Expected supersampled renders to match")
+	}
+}
+
+func TestSplitTilesCoversImageOnce(t *testing.T) {
+	type testCase struct {
+		width  int64
+		height int64
+		size   int64
+		tiles  int
+	}
+	cases := []testCase{
+		{32, 32, 16, 4},
+		{33, 17, 16, 6},
+		{5, 3, 0, 1},
+		{0, 0, 16, 0},
+	}
+	for _, c := range cases {
+		tiles := splitTiles(c.width, c.height, c.size)
+		if len(tiles) != c.tiles {
+			t.Errorf("Expected %d tiles for %dx%d but got %v", c.tiles, c.width, c.height, tiles)
+		}
+		covered := make([]int, c.width*c.height)
+		for _, tl := range tiles {
+			for y := tl.Y0; y < tl.Y1; y++ {
+				for x := tl.X0; x < tl.X1; x++ {
+					covered[y*c.width+x]++
+				}
+			}
+		}
+		for i, n := range covered {
+			if n != 1 {
+				t.Errorf("Expected pixel %d of %dx%d to be covered once but it was covered %d times", i, c.width, c.height, n)
+			}
+		}
+	}
+}
+
+func TestRenderReportsProgress(t *testing.T) {
+	c, w := busyScene(t)
+	reports := []RenderProgress{}
+	opts := RenderOptions{Workers: 4, TileSize: 8, Progress: func(p RenderProgress) {
+		reports = append(reports, p)
+	}}
+	_, err := RenderContext(context.Background(), c, w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 37x23 in 8 pixel tiles is 5 across and 3 down
+	if len(reports) != 15 {
+		t.Fatalf("Expected a report per tile but got %d", len(reports))
+	}
+	for i, p := range reports {
+		if p.DoneTiles != i+1 || p.TotalTiles != 15 || p.TotalPixels != 37*23 {
+			t.Errorf("Expected report %d to count %d of 15 tiles but got %+v", i, i+1, p)
+		}
+		if i > 0 && (p.DonePixels <= reports[i-1].DonePixels || p.Elapsed < reports[i-1].Elapsed) {
+			t.Errorf("Expected progress to only move forward but got %+v after %+v", p, reports[i-1])
+		}
+	}
+	last := reports[len(reports)-1]
+	if last.DonePixels != last.TotalPixels || last.Remaining != 0 {
+		t.Errorf("Expected the last report to be complete but got %+v", last)
+	}
+}
+
+func TestRenderStopsWhenCancelled(t *testing.T) {
+	c, w := busyScene(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := RenderContext(ctx, c, w, RenderOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v to be %v", err, context.Canceled)
+	}
+
+	// Cancelled partway through, after the first tile
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	tiles := 0
+	opts := RenderOptions{Workers: 1, TileSize: 4, Progress: func(p RenderProgress) {
+		tiles = p.DoneTiles
+		cancel()
+	}}
+	_, err = RenderContext(ctx, c, w, opts)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v to be %v", err, context.Canceled)
+	}
+	if tiles >= 2 {
+		t.Errorf("Expected rendering to stop right after cancelling but %d tiles were finished", tiles)
+	}
+}
+
+func TestRenderStopsAtDeadline(t *testing.T) {
+	c, w := busyScene(t)
+	c = NewCamera(400, 400, math.Pi/3)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := RenderContext(ctx, c, w, RenderOptions{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v to be %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected rendering to stop soon after the deadline but it took %v", elapsed)
+	}
+}
+
+func BenchmarkRenderSerial(b *testing.B) {
+	c, w := busyScene(b)
+	for i := 0; i < b.N; i++ {
+		if _, err := RenderContext(context.Background(), c, w, RenderOptions{Workers: 1}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRenderParallel(b *testing.B) {
+	c, w := busyScene(b)
+	for i := 0; i < b.N; i++ {
+		if _, err := Render(c, w); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package world
+
+import (
+	"fmt"
+	"math"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+type SampleMode int
+
+const (
+	// One ray through the center of the pixel
+	SAMPLE_CENTER SampleMode = iota
+	// Grid by Grid rays through the centers of equal cells
+	SAMPLE_STRATIFIED
+	// Grid by Grid rays, each somewhere random within its cell
+	SAMPLE_JITTERED
+	// Starts with a 2 by 2 grid and only adds Grid by Grid jittered rays
+	// where those differ by more than Threshold, like along edges. Grid has
+	// to be at least 3
+	SAMPLE_ADAPTIVE
+)
+
+// How samples within a pixel are weighted by their distance from its center
+type ReconstructionFilter int
+
+const (
+	FILTER_BOX ReconstructionFilter = iota
+	FILTER_TENT
+	FILTER_GAUSSIAN
+)
+
+const DEFAULT_ADAPTIVE_THRESHOLD = 0.1
+
+type Sampling struct {
+	Mode SampleMode
+	// Samples along each side of a pixel, 0 is the same as 1
+	Grid   int
+	Filter ReconstructionFilter
+	// Largest difference in any color channel adaptive sampling accepts
+	// without refining, 0 uses DEFAULT_ADAPTIVE_THRESHOLD
+	Threshold float64
+}
+
+func validateSampling(s Sampling) error {
+	if s.Mode == SAMPLE_ADAPTIVE && s.Grid < 3 {
+		return fmt.Errorf("adaptive sampling needs a grid of at least 3 but got %d", s.Grid)
+	}
+	return nil
+}
+
+// A point within a pixel, 0..1 from its top left corner, where the ray
+// passes through the lens and when during the shutter interval
+type pixelSample struct {
+	X     float64
+	Y     float64
+	LensU float64
+	LensV float64
+	Time  float64
+}
+
+// Lens positions and times are random for every mode, otherwise all of a
+// pixel's rays would go through the same spot on the lens at the same moment
+// and nothing would blur
+func lensSample(px int64, py int64, n int) (float64, float64, float64) {
+	return rtmath.HashToUnit(uint64(px), uint64(py), uint64(n), 2),
+		rtmath.HashToUnit(uint64(px), uint64(py), uint64(n), 3),
+		rtmath.HashToUnit(uint64(px), uint64(py), uint64(n), 4)
+}
+
+// Positions of a grid by grid set of samples within pixel px, py. first
+// numbers the samples, so ones added to a pixel later get their own lens
+// positions and times instead of repeating earlier ones.
+func gridSamples(grid int, jitter bool, px int64, py int64, first int) []pixelSample {
+	samples := make([]pixelSample, 0, grid*grid)
+	for j := 0; j < grid; j++ {
+		for i := 0; i < grid; i++ {
+			dx, dy := 0.5, 0.5
+			if jitter {
+				// Derived from the pixel so renders are reproducible
+				n := uint64(first + j*grid + i)
+				dx = rtmath.HashToUnit(uint64(px), uint64(py), n, 0)
+				dy = rtmath.HashToUnit(uint64(px), uint64(py), n, 1)
+			}
+			lensU, lensV, time := lensSample(px, py, first+j*grid+i)
+			samples = append(samples, pixelSample{(float64(i) + dx) / float64(grid), (float64(j) + dy) / float64(grid), lensU, lensV, time})
+		}
+	}
+	return samples
+}
+
+// dx and dy are offsets from the pixel center, in pixels
+func filterWeight(f ReconstructionFilter, dx float64, dy float64) float64 {
+	switch f {
+	case FILTER_TENT:
+		// Reaches zero one pixel from the center
+		return (1 - math.Abs(dx)) * (1 - math.Abs(dy))
+	case FILTER_GAUSSIAN:
+		// Standard deviation of half a pixel
+		return math.Exp(-2 * (dx*dx + dy*dy))
+	}
+	return 1
+}
+
+func samplePixel(camera Camera, world World, s Sampling, px int64, py int64) (canvas.Color, error) {
+	grid := max(s.Grid, 1)
+
+	var samples []pixelSample
+	switch s.Mode {
+	case SAMPLE_STRATIFIED:
+		samples = gridSamples(grid, false, px, py, 0)
+	case SAMPLE_JITTERED:
+		samples = gridSamples(grid, true, px, py, 0)
+	case SAMPLE_ADAPTIVE:
+		samples = gridSamples(min(grid, 2), false, px, py, 0)
+	default:
+		lensU, lensV, time := lensSample(px, py, 0)
+		samples = []pixelSample{{0.5, 0.5, lensU, lensV, time}}
+	}
+
+	colors, err := traceSamples(camera, world, px, py, samples)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+
+	if s.Mode == SAMPLE_ADAPTIVE && grid > 2 {
+		threshold := s.Threshold
+		if threshold <= 0 {
+			threshold = DEFAULT_ADAPTIVE_THRESHOLD
+		}
+		if contrast(colors) > threshold {
+			// The first rays are as good as any, so they count too
+			refined := gridSamples(grid, true, px, py, len(samples))
+			more, err := traceSamples(camera, world, px, py, refined)
+			if err != nil {
+				return canvas.Color{}, err
+			}
+			samples = append(samples, refined...)
+			colors = append(colors, more...)
+		}
+	}
+
+	return filterSamples(s.Filter, samples, colors), nil
+}
+
+func traceSamples(camera Camera, world World, px int64, py int64, samples []pixelSample) ([]canvas.Color, error) {
+	colors := make([]canvas.Color, len(samples))
+	for i, sample := range samples {
+		ray, err := RayForSample(camera, CameraSample{float64(px) + sample.X, float64(py) + sample.Y, sample.LensU, sample.LensV, sample.Time})
+		if err != nil {
+			return nil, err
+		}
+		colors[i], err = ColorAt(world, ray, world.MaxDepth)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return colors, nil
+}
+
+// Largest spread between the samples in any one channel
+func contrast(colors []canvas.Color) float64 {
+	lo := canvas.NewColor(math.Inf(1), math.Inf(1), math.Inf(1))
+	hi := canvas.NewColor(math.Inf(-1), math.Inf(-1), math.Inf(-1))
+	for _, c := range colors {
+		lo = canvas.NewColor(math.Min(lo.Red, c.Red), math.Min(lo.Green, c.Green), math.Min(lo.Blue, c.Blue))
+		hi = canvas.NewColor(math.Max(hi.Red, c.Red), math.Max(hi.Green, c.Green), math.Max(hi.Blue, c.Blue))
+	}
+	return math.Max(hi.Red-lo.Red, math.Max(hi.Green-lo.Green, hi.Blue-lo.Blue))
+}
+
+func filterSamples(f ReconstructionFilter, samples []pixelSample, colors []canvas.Color) canvas.Color {
+	// A single sample needs no weighting, and skipping it keeps center
+	// sampling exactly as it was
+	if len(colors) == 1 {
+		return colors[0]
+	}
+
+	sum := canvas.NewColor(0, 0, 0)
+	total := 0.
+	for i, c := range colors {
+		w := filterWeight(f, samples[i].X-0.5, samples[i].Y-0.5)
+		sum = canvas.ColorAdd(sum, canvas.ColorScale(c, w))
+		total += w
+	}
+	return canvas.ColorScale(sum, 1/total)
+}
@@ -0,0 +1,208 @@
+package world
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/rtmath"
+)
+
+func TestGridSamples(t *testing.T) {
+	samples := gridSamples(2, false, 3, 4, 0)
+	expected := []pixelSample{{0.25, 0.25, 0, 0, 0}, {0.75, 0.25, 0, 0, 0}, {0.25, 0.75, 0, 0, 0}, {0.75, 0.75, 0, 0, 0}}
+	if len(samples) != len(expected) {
+		t.Fatalf("Expected %v to be %v", samples, expected)
+	}
+	for i := range samples {
+		if !rtmath.FloatEqual(samples[i].X, expected[i].X) || !rtmath.FloatEqual(samples[i].Y, expected[i].Y) {
+			t.Errorf("Expected %v to be %v", samples, expected)
+		}
+	}
+}
+
+func TestJitteredGridSamplesStayInTheirCells(t *testing.T) {
+	samples := gridSamples(3, true, 3, 4, 0)
+	again := gridSamples(3, true, 3, 4, 0)
+	other := gridSamples(3, true, 4, 4, 0)
+	for j := 0; j < 3; j++ {
+		for i := 0; i < 3; i++ {
+			s := samples[j*3+i]
+			if s.X < float64(i)/3 || s.X > float64(i+1)/3 || s.Y < float64(j)/3 || s.Y > float64(j+1)/3 {
+				t.Errorf("Expected %v to be in cell %d, %d", s, i, j)
+			}
+		}
+	}
+	for i := range samples {
+		if samples[i] != again[i] {
+			t.Errorf("Expected the same pixel to get the same samples but got %v and %v", samples, again)
+		}
+	}
+	if samples[0] == other[0] {
+		t.Errorf("Expected different pixels to get different samples")
+	}
+}
+
+func TestLaterGridSamplesGetTheirOwnLensAndTime(t *testing.T) {
+	first := gridSamples(2, false, 3, 4, 0)
+	later := gridSamples(3, true, 3, 4, len(first))
+	for _, a := range first {
+		for _, b := range later {
+			if a.LensU == b.LensU || a.LensV == b.LensV || a.Time == b.Time {
+				t.Errorf("Expected %v and %v to use different lens positions and times", a, b)
+			}
+		}
+	}
+}
+
+func TestFilterWeight(t *testing.T) {
+	type testCase struct {
+		filter   ReconstructionFilter
+		dx       float64
+		dy       float64
+		expected float64
+	}
+	cases := []testCase{
+		{FILTER_BOX, 0, 0, 1},
+		{FILTER_BOX, 0.5, -0.5, 1},
+		{FILTER_TENT, 0, 0, 1},
+		{FILTER_TENT, 0.5, 0, 0.5},
+		{FILTER_TENT, -0.5, 0.5, 0.25},
+		{FILTER_GAUSSIAN, 0, 0, 1},
+		{FILTER_GAUSSIAN, 0.5, 0.5, math.Exp(-1)},
+	}
+	for _, c := range cases {
+		res := filterWeight(c.filter, c.dx, c.dy)
+		if !rtmath.FloatEqual(res, c.expected) {
+			t.Errorf("Expected weight of %v at %v, %v to be %v but got %v", c.filter, c.dx, c.dy, c.expected, res)
+		}
+	}
+}
+
+func TestFilterSamples(t *testing.T) {
+	samples := []pixelSample{{0.5, 0.5, 0.5, 0.5, 0.5}, {0, 0.5, 0.5, 0.5, 0.5}}
+	colors := []canvas.Color{canvas.NewColor(1, 1, 1), canvas.NewColor(0, 0, 0)}
+
+	type testCase struct {
+		filter   ReconstructionFilter
+		expected canvas.Color
+	}
+	cases := []testCase{
+		{FILTER_BOX, canvas.NewColor(0.5, 0.5, 0.5)},
+		// The center sample weighs 1 and the edge one 0.5
+		{FILTER_TENT, canvas.NewColor(2./3, 2./3, 2./3)},
+	}
+	for _, c := range cases {
+		res := filterSamples(c.filter, samples, colors)
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v to be %v", res, c.expected)
+		}
+	}
+}
+
+func samplingCamera(t *testing.T) Camera {
+	c := NewCamera(11, 11, math.Pi/2.)
+	tr, err := ViewTransform(rtmath.Point(0, 0, -5), rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Transform = tr
+	return c
+}
+
+func TestCenterSamplingMatchesRayForPixel(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := samplingCamera(t)
+	r, err := RayForPixel(c, 5, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ColorAt(w, r, w.MaxDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := samplePixel(c, w, Sampling{}, 5, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != expected {
+		t.Errorf("Expected %v to be exactly %v", res, expected)
+	}
+}
+
+func TestSupersamplingSmoothsEdges(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := samplingCamera(t)
+	// The outer sphere's silhouette crosses this pixel
+	center, err := samplePixel(c, w, Sampling{}, 6, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := samplePixel(c, w, Sampling{Mode: SAMPLE_STRATIFIED, Grid: 4}, 6, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	black := canvas.NewColor(0, 0, 0)
+	if canvas.ColorEqual(res, black) || canvas.ColorEqual(res, center) {
+		t.Errorf("Expected %v to blend the sphere with the background unlike %v", res, center)
+	}
+}
+
+func TestAdaptiveSamplingOnlyRefinesWhereNeeded(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := samplingCamera(t)
+	// So the lens positions of the samples make a difference
+	c.Aperture = 0.1
+	c.FocalDistance = 5
+	adaptive := Sampling{Mode: SAMPLE_ADAPTIVE, Grid: 4, Filter: FILTER_TENT}
+
+	type testCase struct {
+		x       int64
+		y       int64
+		refined bool
+	}
+	cases := []testCase{
+		// Plain background, so the first four samples are enough
+		{0, 0, false},
+		// Along the silhouette it adds the full jittered grid to them
+		{6, 5, true},
+	}
+	for _, tc := range cases {
+		res, err := samplePixel(c, w, adaptive, tc.x, tc.y)
+		if err != nil {
+			t.Fatal(err)
+		}
+		samples := gridSamples(2, false, tc.x, tc.y, 0)
+		if tc.refined {
+			samples = append(samples, gridSamples(4, true, tc.x, tc.y, 4)...)
+		}
+		colors, err := traceSamples(c, w, tc.x, tc.y, samples)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := filterSamples(FILTER_TENT, samples, colors)
+		if res != expected {
+			t.Errorf("Expected %v at %d, %d to be %v", res, tc.x, tc.y, expected)
+		}
+	}
+}
+
+func TestAdaptiveSamplingNeedsGridOfThree(t *testing.T) {
+	c, w := busyScene(t)
+	for _, grid := range []int{0, 1, 2} {
+		_, err := RenderContext(context.Background(), c, w, RenderOptions{Sampling: Sampling{Mode: SAMPLE_ADAPTIVE, Grid: grid}})
+		if err == nil {
+			t.Errorf("Expected an error for adaptive sampling with a grid of %d", grid)
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package world
+
+import (
+	"math"
+	"reflect"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+// How many times rays bounce off reflective surfaces by default
+const DEFAULT_MAX_DEPTH = 5
+
+type World struct {
+	Objects []geometry.Shape
+	Lights  []shading.Light
+	// Limits how many times Render lets rays bounce, a World{} has 0 so
+	// nothing is reflected
+	MaxDepth int
+	// Only set on RenderContext's own copy of the World
+	bvh *geometry.BVH
+}
+
+type Computation struct {
+	Object   geometry.Shape
+	T        float64
+	Point    rtmath.Tuple
+	EyeV     rtmath.Tuple
+	NormalV  rtmath.Tuple
+	IsInside bool
+	ReflectV rtmath.Tuple
+	// Point nudged along the normal so shadow rays don't hit the surface itself
+	OverPoint rtmath.Tuple
+	// Point nudged below the surface where refracted rays start
+	UnderPoint rtmath.Tuple
+	// Refractive indices of the materials the ray leaves and enters
+	N1 float64
+	N2 float64
+	// Time of the ray, which secondary rays keep so moving shapes stay put
+	// while a hit is shaded
+	Time float64
+}
+
+func NewWorld() World {
+	return World{
+		Objects:  []geometry.Shape{},
+		Lights:   []shading.Light{},
+		MaxDepth: DEFAULT_MAX_DEPTH,
+	}
+}
+
+func DefaultWorld() (World, error) {
+	l, err := shading.NewPointLight(rtmath.Point(-10, 10, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		return World{}, err
+	}
+	ls := []shading.Light{l}
+	s1 := geometry.NewSphere()
+	m := shading.NewMaterial()
+	m.Color = canvas.NewColor(0.8, 1.0, 0.6)
+	m.Diffuse = 0.7
+	m.Specular = 0.2
+	s1.Material = m
+
+	s2 := geometry.NewSphere()
+	s2.Transform = rtmath.Scaling(0.5, 0.5, 0.5)
+
+	return World{
+		Objects:  []geometry.Shape{s1, s2},
+		Lights:   ls,
+		MaxDepth: DEFAULT_MAX_DEPTH,
+	}, nil
+}
+
+// Builds the bounding volume hierarchy WorldRayIntersect uses instead of
+// testing every object. The BVH keeps the transforms the shapes had when it
+// was built, so it is only built on a copy of the World that lives no longer
+// than a single render.
+func buildWorldBVH(w *World) error {
+	bvh, err := geometry.BuildBVH(w.Objects)
+	if err != nil {
+		return err
+	}
+	w.bvh = bvh
+	return nil
+}
+
+func WorldRayIntersect(w World, r geometry.Ray) ([]geometry.Intersection, error) {
+	if w.bvh != nil {
+		return geometry.BVHIntersect(w.bvh, r)
+	}
+
+	intersections := []geometry.Intersection{}
+	for _, s := range w.Objects {
+		is, err := geometry.Intersect(s, r)
+		if err != nil {
+			return []geometry.Intersection{}, err
+		}
+		intersections = append(intersections, is...)
+	}
+
+	return geometry.SortIntersections(intersections), nil
+}
+
+// xs are all the intersections along r, sorted, and are used to find which
+// materials the hit sits between
+func PrepareComputations(i geometry.Intersection, r geometry.Ray, xs []geometry.Intersection) (Computation, error) {
+	p := geometry.RayPosition(r, i.T)
+	n, err := geometry.NormalAt(i.Object, p, i)
+	if err != nil {
+		return Computation{}, err
+	}
+	isInside := false
+	eye := rtmath.TupleNegate(r.Direction)
+	if rtmath.VectorDot(n, eye) < 0 {
+		isInside = true
+		n = rtmath.TupleNegate(n)
+	}
+
+	reflectV := rtmath.VectorNormalReflect(r.Direction, n)
+	overPoint := rtmath.TupleAdd(p, rtmath.TupleScale(n, rtmath.EPSILON))
+	underPoint := rtmath.TupleSubtract(p, rtmath.TupleScale(n, rtmath.EPSILON))
+	n1, n2 := refractiveIndices(i, xs)
+
+	return Computation{i.Object, i.T, p, eye, n, isInside, reflectV, overPoint, underPoint, n1, n2, r.Time}, nil
+}
+
+// Tracks which objects the ray is inside of on its way to the hit
+func refractiveIndices(hit geometry.Intersection, xs []geometry.Intersection) (float64, float64) {
+	n1, n2 := shading.REFRACTIVE_INDEX_VACUUM, shading.REFRACTIVE_INDEX_VACUUM
+	containers := []geometry.Shape{}
+	for _, i := range xs {
+		isHit := i == hit
+		if isHit && len(containers) > 0 {
+			n1 = containers[len(containers)-1].GetMaterial().RefractiveIndex
+		}
+
+		found := false
+		for j, c := range containers {
+			if c == i.Object {
+				containers = append(containers[:j], containers[j+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			containers = append(containers, i.Object)
+		}
+
+		if isHit {
+			if len(containers) > 0 {
+				n2 = containers[len(containers)-1].GetMaterial().RefractiveIndex
+			}
+			break
+		}
+	}
+	return n1, n2
+}
+
+// remaining is how many more times the ray may bounce
+func ShadeHit(world World, comps Computation, remaining int) (canvas.Color, error) {
+	objectPoint, err := geometry.WorldToObject(comps.Object, comps.OverPoint, comps.Time)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+
+	color := canvas.NewColor(0, 0, 0)
+	for _, l := range world.Lights {
+		intensity, err := IntensityAt(world, l, comps.OverPoint, comps.Time)
+		if err != nil {
+			return canvas.Color{}, err
+		}
+		c, err := shading.Lighting(comps.Object.GetMaterial(),
+			objectPoint,
+			l,
+			comps.OverPoint,
+			comps.EyeV,
+			comps.NormalV,
+			intensity)
+		if err != nil {
+			return canvas.Color{}, err
+		}
+		color = canvas.ColorAdd(color, c)
+	}
+
+	reflected, err := ReflectedColor(world, comps, remaining)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	refracted, err := RefractedColor(world, comps, remaining)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+
+	m := comps.Object.GetMaterial()
+	if m.Reflective > 0 && m.Transparency > 0 {
+		reflectance := Schlick(comps)
+		reflected = canvas.ColorScale(reflected, reflectance)
+		refracted = canvas.ColorScale(refracted, 1-reflectance)
+	}
+	return canvas.ColorAdd(canvas.ColorAdd(color, reflected), refracted), nil
+}
+
+func ReflectedColor(world World, comps Computation, remaining int) (canvas.Color, error) {
+	reflective := comps.Object.GetMaterial().Reflective
+	if remaining <= 0 || reflective == 0 {
+		return canvas.NewColor(0, 0, 0), nil
+	}
+
+	r, err := geometry.NewRay(comps.OverPoint, comps.ReflectV)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	r.Time = comps.Time
+	color, err := ColorAt(world, r, remaining-1)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	return canvas.ColorScale(color, reflective), nil
+}
+
+func RefractedColor(world World, comps Computation, remaining int) (canvas.Color, error) {
+	transparency := comps.Object.GetMaterial().Transparency
+	if remaining <= 0 || transparency == 0 {
+		return canvas.NewColor(0, 0, 0), nil
+	}
+
+	// Snell's law, nothing gets through past the critical angle
+	nRatio := comps.N1 / comps.N2
+	cosI := rtmath.VectorDot(comps.EyeV, comps.NormalV)
+	sin2T := nRatio * nRatio * (1 - cosI*cosI)
+	if sin2T > 1 {
+		return canvas.NewColor(0, 0, 0), nil
+	}
+
+	cosT := math.Sqrt(1 - sin2T)
+	dir := rtmath.TupleSubtract(
+		rtmath.TupleScale(comps.NormalV, nRatio*cosI-cosT),
+		rtmath.TupleScale(comps.EyeV, nRatio))
+	r, err := geometry.NewRay(comps.UnderPoint, dir)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	r.Time = comps.Time
+	color, err := ColorAt(world, r, remaining-1)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	return canvas.ColorScale(color, transparency), nil
+}
+
+// Approximates the fraction of light reflected rather than refracted
+func Schlick(comps Computation) float64 {
+	cos := rtmath.VectorDot(comps.EyeV, comps.NormalV)
+	if comps.N1 > comps.N2 {
+		n := comps.N1 / comps.N2
+		sin2T := n * n * (1 - cos*cos)
+		if sin2T > 1 {
+			// Total internal reflection
+			return 1
+		}
+		cos = math.Sqrt(1 - sin2T)
+	}
+
+	r0 := math.Pow((comps.N1-comps.N2)/(comps.N1+comps.N2), 2)
+	return r0 + (1-r0)*math.Pow(1-cos, 5)
+}
+
+func ColorAt(w World, r geometry.Ray, remaining int) (canvas.Color, error) {
+	is, err := WorldRayIntersect(w, r)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	h := geometry.Hit(is)
+	if reflect.ValueOf(h).IsZero() {
+		return canvas.NewColor(0, 0, 0), nil
+	}
+	comps, err := PrepareComputations(h, r, is)
+	if err != nil {
+		return canvas.Color{}, err
+	}
+	return ShadeHit(w, comps, remaining)
+}
+
+// Whether every sample of the light is blocked from reaching p, with moving
+// shapes where they are at time. A light that doesn't reach p anyway, like
+// a spot light pointed elsewhere, only shadows p if something is in the way
+// too.
+func IsShadowed(w World, l shading.Light, p rtmath.Tuple, time float64) (bool, error) {
+	unblocked, err := unblockedFraction(w, l, p, time)
+	if err != nil {
+		return false, err
+	}
+	return unblocked == 0, nil
+}
+
+// Fraction of the light's samples that reach p, either 0 or 1 for lights
+// with a single sample. Moving shapes cast their shadows from where they are
+// at time.
+func IntensityAt(w World, l shading.Light, p rtmath.Tuple, time float64) (float64, error) {
+	// No point casting shadow rays toward a light that can't reach p, like
+	// one that is out of range or pointed elsewhere
+	if l.FalloffAt(p) == 0 {
+		return 0, nil
+	}
+	return unblockedFraction(w, l, p, time)
+}
+
+// Fraction of the light's samples with nothing between them and p
+func unblockedFraction(w World, l shading.Light, p rtmath.Tuple, time float64) (float64, error) {
+	samples := l.LightSamples(p)
+	total := 0.
+	for _, sample := range samples {
+		blocked, err := isBlocked(w, p, sample, time)
+		if err != nil {
+			return 0, err
+		}
+		if !blocked {
+			total++
+		}
+	}
+	return total / float64(len(samples)), nil
+}
+
+func isBlocked(w World, p rtmath.Tuple, sample shading.LightSample, time float64) (bool, error) {
+	r, err := geometry.NewRay(p, sample.Direction)
+	if err != nil {
+		return false, err
+	}
+	r.Time = time
+	intersections, err := WorldRayIntersect(w, r)
+	if err != nil {
+		return false, err
+	}
+	h := geometry.Hit(intersections)
+	hitPresent := !reflect.DeepEqual(h, geometry.Intersection{})
+
+	return hitPresent && h.T < sample.Distance, nil
+}
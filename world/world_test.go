@@ -0,0 +1,1289 @@
+package world
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"ray-tracer-challenge/canvas"
+	"ray-tracer-challenge/geometry"
+	"ray-tracer-challenge/rtmath"
+	"ray-tracer-challenge/shading"
+)
+
+func TestCreateWorld(t *testing.T) {
+	w := World{}
+
+	if len(w.Lights) != 0 {
+		t.Errorf("Expected there to be no light source but there is %v", w.Lights[0])
+	}
+
+	if len(w.Objects) != 0 {
+		t.Errorf("Expected there to be no objects but there are %v", w.Objects)
+	}
+}
+
+func TestDefaultWorld(t *testing.T) {
+	l, err := shading.NewPointLight(rtmath.Point(-10, 10, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1 := geometry.NewSphere()
+	m := shading.NewMaterial()
+	m.Color = canvas.NewColor(0.8, 1.0, 0.6)
+	m.Diffuse = 0.7
+	m.Specular = 0.2
+	s1.Material = m
+
+	s2 := geometry.NewSphere()
+	s2.Transform = rtmath.Scaling(0.5, 0.5, 0.5)
+
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := World{
+		Objects:  []geometry.Shape{s1, s2},
+		Lights:   []shading.Light{l},
+		MaxDepth: DEFAULT_MAX_DEPTH,
+	}
+
+	if !reflect.DeepEqual(w.Lights[0], l) ||
+		!reflect.DeepEqual(w.Objects[0], s1) ||
+		!reflect.DeepEqual(w.Objects[1], s2) {
+		t.Errorf("Error with default world %v is supposed to be %v", w, expected)
+	}
+}
+
+func TestIntersectWorldWithRay(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xs, err := WorldRayIntersect(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 4 ||
+		!rtmath.FloatEqual(xs[0].T, 4) ||
+		!rtmath.FloatEqual(xs[1].T, 4.5) ||
+		!rtmath.FloatEqual(xs[2].T, 5.5) ||
+		!rtmath.FloatEqual(xs[3].T, 6) {
+		t.Errorf("Expected ts to be [4,4.5,5.5,6] but intersection array is %v", xs)
+	}
+}
+
+func TestPrecomputeStateOfIntersection(t *testing.T) {
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shape := geometry.NewSphere()
+	i := geometry.Intersection{Object: shape, T: 4}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Computation{i.Object, i.T, rtmath.Point(0, 0, -1), rtmath.Vector(0, 0, -1), rtmath.Vector(0, 0, -1), false, rtmath.Vector(0, 0, -1), rtmath.Point(0, 0, -1-rtmath.EPSILON), rtmath.Point(0, 0, -1+rtmath.EPSILON), 1, 1, 0}
+
+	if !rtmath.FloatEqual(comps.T, expected.T) ||
+		!reflect.DeepEqual(comps.Object, expected.Object) ||
+		!rtmath.TupleEqual(comps.Point, expected.Point) ||
+		!rtmath.TupleEqual(comps.EyeV, expected.EyeV) ||
+		!rtmath.TupleEqual(comps.NormalV, expected.NormalV) {
+		t.Errorf("Expected %v to equal %v", comps, expected)
+	}
+}
+
+func TestHitWhenIntersectionIsOutside(t *testing.T) {
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := geometry.NewSphere()
+	i := geometry.Intersection{Object: shape, T: 4}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comps.IsInside {
+		t.Errorf("Expected IsInside to be false but it is true")
+	}
+}
+
+func TestHitWhenIntersectionIsInside(t *testing.T) {
+	r, err := geometry.NewRay(rtmath.Point(0, 0, 0), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := geometry.NewSphere()
+	i := geometry.Intersection{Object: shape, T: 1}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Computation{i.Object, i.T, rtmath.Point(0, 0, 1), rtmath.Vector(0, 0, -1), rtmath.Vector(0, 0, -1), true, rtmath.Vector(0, 0, -1), rtmath.Point(0, 0, 1-rtmath.EPSILON), rtmath.Point(0, 0, 1+rtmath.EPSILON), 1, 1, 0}
+
+	if !rtmath.FloatEqual(comps.T, expected.T) ||
+		!rtmath.TupleEqual(comps.Point, expected.Point) ||
+		!rtmath.TupleEqual(comps.EyeV, expected.EyeV) ||
+		!rtmath.TupleEqual(comps.NormalV, expected.NormalV) ||
+		!comps.IsInside {
+		t.Errorf("Expected %v to equal %v", comps, expected)
+	}
+}
+
+func TestShadeAnIntersection(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := w.Objects[0]
+	i := geometry.Intersection{Object: shape, T: 4}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.38066, 0.47583, 0.2855)
+
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("%v not equal to %v", c, expected)
+	}
+}
+
+func TestShadeHitGivenIntersectionInShadow(t *testing.T) {
+	l, err := shading.NewPointLight(rtmath.Point(0, 0, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1 := geometry.NewSphere()
+	s2 := geometry.NewSphere()
+	s2.Transform = rtmath.Translation(0, 0, 10)
+	w := World{Objects: []geometry.Shape{s1, s2}, Lights: []shading.Light{l}, MaxDepth: DEFAULT_MAX_DEPTH}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, 5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: s2, T: 4}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.1, 0.1, 0.1)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("%v not equal to %v", c, expected)
+	}
+}
+
+func TestShadeHitGivenUnshadowedPointInDefaultWorld(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Looking down at the top of the outer sphere, which faces the light
+	r, err := geometry.NewRay(rtmath.Point(0, 5, 0), rtmath.Vector(0, -1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: w.Objects[0], T: 4}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shadowed, err := IsShadowed(w, w.Lights[0], comps.OverPoint, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shadowed {
+		t.Fatalf("Expected %v not to be in shadow", comps.OverPoint)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ambient := canvas.ColorScale(canvas.NewColor(0.8, 1.0, 0.6), 0.1)
+	if c.Red <= ambient.Red || c.Green <= ambient.Green || c.Blue <= ambient.Blue {
+		t.Errorf("Expected %v to be brighter than the ambient color %v", c, ambient)
+	}
+}
+
+func TestShadeHitGivenShadowedPointInDefaultWorld(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	floor := geometry.NewPlane()
+	floor.Transform = rtmath.Translation(0, -1, 0)
+	w.Objects = append(w.Objects, floor)
+	// The spheres stand between the light at (-10, 10, -10) and this point
+	target := rtmath.Point(1, -1, 1)
+	origin := rtmath.Point(1, 4, 1)
+	r, err := geometry.NewRay(origin, rtmath.Vector(0, -1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: floor, T: origin.Y - target.Y}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.1, 0.1, 0.1)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("%v not equal to %v", c, expected)
+	}
+}
+
+func TestHitShouldOffsetThePoint(t *testing.T) {
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := geometry.NewSphere()
+	shape.Transform = rtmath.Translation(0, 0, 1)
+	i := geometry.Intersection{Object: shape, T: 5}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comps.OverPoint.Z >= -rtmath.EPSILON/2 || comps.Point.Z <= comps.OverPoint.Z {
+		t.Errorf("Expected %v to be nudged above %v", comps.OverPoint, comps.Point)
+	}
+}
+
+func TestShadeAnIntersectionFromInside(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := shading.NewPointLight(rtmath.Point(0, 0.25, 0), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Lights[0] = l
+	r, err := geometry.NewRay(rtmath.Point(0, 0, 0), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := w.Objects[1]
+	i := geometry.Intersection{Object: shape, T: 0.5}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.90498, 0.90498, 0.90498)
+
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("%v not equal to %v", c, expected)
+	}
+}
+
+func TestColorWhenRayMisses(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ColorAt(w, r, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0, 0, 0)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestColorWhenRayHits(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ColorAt(w, r, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.38066, 0.47583, 0.2855)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestColorWhenIntersectionBehindRay(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Objects[0].(*geometry.Sphere).Material.Ambient = 1.0
+	w.Objects[1].(*geometry.Sphere).Material.Ambient = 1.0
+	r, err := geometry.NewRay(rtmath.Point(0, 0, 0.75), rtmath.Vector(0, 0, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ColorAt(w, r, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := w.Objects[1].(*geometry.Sphere).Material.Color
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestNoShadowWhenNothingCollinearWithPointAndLight(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := rtmath.Point(0, 10, 0)
+	res, err := IsShadowed(w, w.Lights[0], p, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := false
+	if res != expect {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestShadowWhenObjectBetweenPointAndLight(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := rtmath.Point(10, -10, 10)
+	res, err := IsShadowed(w, w.Lights[0], p, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := true
+	if res != expect {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestNoShadowWhenObjectBehindLight(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := rtmath.Point(-20, 20, 20)
+	res, err := IsShadowed(w, w.Lights[0], p, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := false
+	if res != expect {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestNoShadowWhenObjectBehindPoint(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := rtmath.Point(-2, 2, -2)
+	res, err := IsShadowed(w, w.Lights[0], p, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := false
+	if res != expect {
+		t.Errorf("Expected %v to be %v", res, expect)
+	}
+}
+
+func TestIntersectWorldWithPlane(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	floor := geometry.NewPlane()
+	floor.Transform = rtmath.Translation(0, -1, 0)
+	w.Objects = append(w.Objects, floor)
+	r, err := geometry.NewRay(rtmath.Point(0, 5, -3), rtmath.Vector(0, -1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := WorldRayIntersect(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := geometry.Hit(xs)
+	if len(xs) != 1 || h.Object != floor || !rtmath.FloatEqual(h.T, 6) {
+		t.Errorf("Expected a single hit on the floor at t=6 but got %v", xs)
+	}
+}
+
+func TestPrepareComputationsWithSmoothTriangle(t *testing.T) {
+	tri := geometry.NewSmoothTriangle(
+		rtmath.Point(0, 1, 0), rtmath.Point(-1, 0, 0), rtmath.Point(1, 0, 0),
+		rtmath.Vector(0, 1, 0), rtmath.Vector(-1, 0, 0), rtmath.Vector(1, 0, 0))
+	i := geometry.NewIntersectionWithUV(tri, 1, 0.45, 0.25)
+	r, err := geometry.NewRay(rtmath.Point(-0.2, 0.3, -2), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(-0.5547, 0.83205, 0)
+	if !rtmath.TupleEqual(comps.NormalV, expected) {
+		t.Errorf("Expected %v to equal %v", comps.NormalV, expected)
+	}
+}
+
+func TestPrepareComputationsOnShapeInGroup(t *testing.T) {
+	g := geometry.NewGroup()
+	g.Transform = rtmath.Translation(0, 0, 5)
+	s := geometry.NewSphere()
+	s.Transform = rtmath.Scaling(2, 2, 2)
+	g.AddChild(s)
+	w := World{Objects: []geometry.Shape{g}, Lights: []shading.Light{}}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs, err := WorldRayIntersect(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := geometry.Hit(xs)
+	if h.Object != s || !rtmath.FloatEqual(h.T, 8) {
+		t.Fatalf("Expected to hit the sphere at t=8 but got %v", xs)
+	}
+	comps, err := PrepareComputations(h, r, xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rtmath.TupleEqual(comps.Point, rtmath.Point(0, 0, 3)) || !rtmath.TupleEqual(comps.NormalV, rtmath.Vector(0, 0, -1)) {
+		t.Errorf("Expected point (0, 0, 3) with normal (0, 0, -1) but got %v", comps)
+	}
+}
+
+func TestShadeCSGUsesChildMaterial(t *testing.T) {
+	s1 := geometry.NewSphere()
+	s1.Material.Color = canvas.NewColor(1, 0, 0)
+	s1.Material.Ambient = 1
+	s1.Material.Diffuse = 0
+	s1.Material.Specular = 0
+	s2 := geometry.NewSphere()
+	s2.Transform = rtmath.Translation(0, 0, -0.5)
+	s2.Material.Color = canvas.NewColor(0, 0, 1)
+	s2.Material.Ambient = 1
+	s2.Material.Diffuse = 0
+	s2.Material.Specular = 0
+	c := geometry.NewCSG(geometry.CSG_DIFFERENCE, s1, s2)
+	l, err := shading.NewPointLight(rtmath.Point(0, 0, -10), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := World{Objects: []geometry.Shape{c}, Lights: []shading.Light{l}, MaxDepth: DEFAULT_MAX_DEPTH}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The front of s1 is carved away, so the ray first hits the back of s2
+	col, err := ColorAt(w, r, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0, 0, 1)
+	if !canvas.ColorEqual(col, expected) {
+		t.Errorf("Expected %v to equal %v", col, expected)
+	}
+}
+
+func TestPrecomputeReflectionVector(t *testing.T) {
+	shape := geometry.NewPlane()
+	r, err := geometry.NewRay(rtmath.Point(0, 1, -1), rtmath.Vector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: shape, T: math.Sqrt2}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rtmath.Vector(0, math.Sqrt2/2, math.Sqrt2/2)
+	if !rtmath.TupleEqual(comps.ReflectV, expected) {
+		t.Errorf("Expected %v to equal %v", comps.ReflectV, expected)
+	}
+}
+
+func TestReflectedColorForNonreflectiveMaterial(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, 0), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := w.Objects[1].(*geometry.Sphere)
+	shape.Material.Ambient = 1
+	i := geometry.Intersection{Object: shape, T: 1}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ReflectedColor(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0, 0, 0)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func reflectiveFloorWorld() (World, *geometry.Plane, error) {
+	w, err := DefaultWorld()
+	if err != nil {
+		return World{}, nil, err
+	}
+	shape := geometry.NewPlane()
+	shape.Material.Reflective = 0.5
+	shape.Transform = rtmath.Translation(0, -1, 0)
+	w.Objects = append(w.Objects, shape)
+	return w, shape, nil
+}
+
+func TestReflectedColorForReflectiveMaterial(t *testing.T) {
+	w, shape, err := reflectiveFloorWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -3), rtmath.Vector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: shape, T: math.Sqrt2}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ReflectedColor(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.19033, 0.23791, 0.14274)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestShadeHitWithReflectiveMaterial(t *testing.T) {
+	w, shape, err := reflectiveFloorWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -3), rtmath.Vector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: shape, T: math.Sqrt2}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.87676, 0.92434, 0.82917)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestColorAtWithMutuallyReflectiveSurfaces(t *testing.T) {
+	l, err := shading.NewPointLight(rtmath.Point(0, 0, 0), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lower := geometry.NewPlane()
+	lower.Material.Reflective = 1
+	lower.Transform = rtmath.Translation(0, -1, 0)
+	upper := geometry.NewPlane()
+	upper.Material.Reflective = 1
+	upper.Transform = rtmath.Translation(0, 1, 0)
+	w := NewWorld()
+	w.Objects = []geometry.Shape{lower, upper}
+	w.Lights = []shading.Light{l}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Terminating at all is what this checks
+	_, err = ColorAt(w, r, w.MaxDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReflectedColorAtMaximumRecursiveDepth(t *testing.T) {
+	w, shape, err := reflectiveFloorWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -3), rtmath.Vector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: shape, T: math.Sqrt2}
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ReflectedColor(w, comps, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0, 0, 0)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func glassSphere() *geometry.Sphere {
+	s := geometry.NewSphere()
+	s.Material.Transparency = 1
+	s.Material.RefractiveIndex = shading.REFRACTIVE_INDEX_GLASS
+	return s
+}
+
+func TestFindN1AndN2AtVariousIntersections(t *testing.T) {
+	a := glassSphere()
+	a.Transform = rtmath.Scaling(2, 2, 2)
+	a.Material.RefractiveIndex = 1.5
+	b := glassSphere()
+	b.Transform = rtmath.Translation(0, 0, -0.25)
+	b.Material.RefractiveIndex = 2
+	c := glassSphere()
+	c.Transform = rtmath.Translation(0, 0, 0.25)
+	c.Material.RefractiveIndex = 2.5
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -4), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := geometry.Intersections(
+		geometry.NewIntersection(a, 2),
+		geometry.NewIntersection(b, 2.75),
+		geometry.NewIntersection(c, 3.25),
+		geometry.NewIntersection(b, 4.75),
+		geometry.NewIntersection(c, 5.25),
+		geometry.NewIntersection(a, 6),
+	)
+
+	type testCase struct {
+		n1 float64
+		n2 float64
+	}
+	cases := []testCase{
+		{1.0, 1.5},
+		{1.5, 2.0},
+		{2.0, 2.5},
+		{2.5, 2.5},
+		{2.5, 1.5},
+		{1.5, 1.0},
+	}
+	for i, c := range cases {
+		comps, err := PrepareComputations(xs[i], r, xs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.FloatEqual(comps.N1, c.n1) || !rtmath.FloatEqual(comps.N2, c.n2) {
+			t.Errorf("Expected n1, n2 at %d to be %v, %v but got %v, %v", i, c.n1, c.n2, comps.N1, comps.N2)
+		}
+	}
+}
+
+func TestUnderPointIsOffsetBelowSurface(t *testing.T) {
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := glassSphere()
+	shape.Transform = rtmath.Translation(0, 0, 1)
+	i := geometry.NewIntersection(shape, 5)
+	comps, err := PrepareComputations(i, r, geometry.Intersections(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comps.UnderPoint.Z <= rtmath.EPSILON/2 || comps.Point.Z >= comps.UnderPoint.Z {
+		t.Errorf("Expected %v to be below the surface at %v", comps.UnderPoint, comps.Point)
+	}
+}
+
+func TestRefractedColorWithOpaqueSurface(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := w.Objects[0]
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := geometry.Intersections(geometry.NewIntersection(shape, 4), geometry.NewIntersection(shape, 6))
+	comps, err := PrepareComputations(xs[0], r, xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := RefractedColor(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0, 0, 0)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestRefractedColorAtMaximumRecursiveDepth(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := w.Objects[0].(*geometry.Sphere)
+	shape.Material.Transparency = 1
+	shape.Material.RefractiveIndex = 1.5
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := geometry.Intersections(geometry.NewIntersection(shape, 4), geometry.NewIntersection(shape, 6))
+	comps, err := PrepareComputations(xs[0], r, xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := RefractedColor(w, comps, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0, 0, 0)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestRefractedColorUnderTotalInternalReflection(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := w.Objects[0].(*geometry.Sphere)
+	shape.Material.Transparency = 1
+	shape.Material.RefractiveIndex = 1.5
+	r, err := geometry.NewRay(rtmath.Point(0, 0, math.Sqrt2/2), rtmath.Vector(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := geometry.Intersections(geometry.NewIntersection(shape, -math.Sqrt2/2), geometry.NewIntersection(shape, math.Sqrt2/2))
+	// Inside the sphere, so the second intersection is the one that matters
+	comps, err := PrepareComputations(xs[1], r, xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := RefractedColor(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0, 0, 0)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func transparentFloorWorld() (World, *geometry.Plane, error) {
+	w, err := DefaultWorld()
+	if err != nil {
+		return World{}, nil, err
+	}
+	floor := geometry.NewPlane()
+	floor.Transform = rtmath.Translation(0, -1, 0)
+	floor.Material.Transparency = 0.5
+	floor.Material.RefractiveIndex = 1.5
+	ball := geometry.NewSphere()
+	ball.Material.Color = canvas.NewColor(1, 0, 0)
+	ball.Material.Ambient = 0.5
+	ball.Transform = rtmath.Translation(0, -3.5, -0.5)
+	w.Objects = append(w.Objects, floor, ball)
+	return w, floor, nil
+}
+
+func TestShadeHitWithTransparentMaterial(t *testing.T) {
+	w, floor, err := transparentFloorWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -3), rtmath.Vector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := geometry.Intersections(geometry.NewIntersection(floor, math.Sqrt2))
+	comps, err := PrepareComputations(xs[0], r, xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.93642, 0.68642, 0.68642)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestShadeHitWithReflectiveTransparentMaterial(t *testing.T) {
+	w, floor, err := transparentFloorWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	floor.Material.Reflective = 0.5
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -3), rtmath.Vector(0, -math.Sqrt2/2, math.Sqrt2/2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := geometry.Intersections(geometry.NewIntersection(floor, math.Sqrt2))
+	comps, err := PrepareComputations(xs[0], r, xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ShadeHit(w, comps, DEFAULT_MAX_DEPTH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := canvas.NewColor(0.93391, 0.69643, 0.69243)
+	if !canvas.ColorEqual(c, expected) {
+		t.Errorf("Expected %v to equal %v", c, expected)
+	}
+}
+
+func TestSchlick(t *testing.T) {
+	shape := glassSphere()
+
+	type testCase struct {
+		origin      rtmath.Tuple
+		direction   rtmath.Tuple
+		ts          []float64
+		hit         int
+		reflectance float64
+	}
+	cases := []testCase{
+		// Total internal reflection
+		{rtmath.Point(0, 0, math.Sqrt2/2), rtmath.Vector(0, 1, 0), []float64{-math.Sqrt2 / 2, math.Sqrt2 / 2}, 1, 1},
+		// Perpendicular viewing angle
+		{rtmath.Point(0, 0, 0), rtmath.Vector(0, 1, 0), []float64{-1, 1}, 1, 0.04},
+		// Small angle with n2 > n1
+		{rtmath.Point(0, 0.99, -2), rtmath.Vector(0, 0, 1), []float64{1.8589}, 0, 0.48873},
+	}
+	for _, c := range cases {
+		r, err := geometry.NewRay(c.origin, c.direction)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs := []geometry.Intersection{}
+		for _, ti := range c.ts {
+			xs = append(xs, geometry.NewIntersection(shape, ti))
+		}
+		comps, err := PrepareComputations(xs[c.hit], r, xs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reflectance := Schlick(comps)
+		if !rtmath.FloatEqual(reflectance, c.reflectance) {
+			t.Errorf("Expected %v to be %v", reflectance, c.reflectance)
+		}
+	}
+}
+
+func TestShadeHitWithObjectAndPatternTransformations(t *testing.T) {
+	white := canvas.NewColor(1, 1, 1)
+	black := canvas.NewColor(0, 0, 0)
+	l, err := shading.NewPointLight(rtmath.Point(-10, 0, 0), white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Hits the unit sphere at x = -0.866 and the doubled one at x = -1.936
+	r, err := geometry.NewRay(rtmath.Point(-5, 0, 0.5), rtmath.Vector(1, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		objectTransform  rtmath.Matrix
+		patternTransform rtmath.Matrix
+		expected         canvas.Color
+	}
+	cases := []testCase{
+		{rtmath.Scaling(2, 2, 2), rtmath.MatrixConstructIdentity(4), black},
+		{rtmath.MatrixConstructIdentity(4), rtmath.Scaling(0.5, 0.5, 0.5), white},
+		{rtmath.Scaling(2, 2, 2), rtmath.Translation(0.5, 0, 0), white},
+	}
+	for _, c := range cases {
+		s := geometry.NewSphere()
+		s.Transform = c.objectTransform
+		pattern := shading.NewStripePattern(white, black)
+		pattern.Transform = c.patternTransform
+		s.Material.Pattern = pattern
+		s.Material.Ambient = 1
+		s.Material.Diffuse = 0
+		s.Material.Specular = 0
+		w := NewWorld()
+		w.Objects = []geometry.Shape{s}
+		w.Lights = []shading.Light{l}
+
+		res, err := ColorAt(w, r, w.MaxDepth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v to be %v", res, c.expected)
+		}
+	}
+}
+
+func TestPointLightIntensityAt(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	type testCase struct {
+		point    rtmath.Tuple
+		expected float64
+	}
+	cases := []testCase{
+		{rtmath.Point(0, 1.0001, 0), 1},
+		{rtmath.Point(-1.0001, 0, 0), 1},
+		{rtmath.Point(0, 0, -1.0001), 1},
+		{rtmath.Point(0, 0, 1.0001), 0},
+		{rtmath.Point(1.0001, 0, 0), 0},
+		{rtmath.Point(0, -1.0001, 0), 0},
+		{rtmath.Point(0, 0, 0), 0},
+	}
+	for _, c := range cases {
+		res, err := IntensityAt(w, w.Lights[0], c.point, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.FloatEqual(res, c.expected) {
+			t.Errorf("Expected intensity at %v to be %v but got %v", c.point, c.expected, res)
+		}
+	}
+}
+
+func TestAreaLightIntensityAt(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := shading.NewAreaLight(rtmath.Point(-0.5, -0.5, -5), rtmath.Vector(1, 0, 0), 2, rtmath.Vector(0, 1, 0), 2, canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Jitter = false
+
+	type testCase struct {
+		point    rtmath.Tuple
+		expected float64
+	}
+	cases := []testCase{
+		{rtmath.Point(0, 0, 2), 0.0},
+		{rtmath.Point(1, -1, 2), 0.25},
+		{rtmath.Point(1.5, 0, 2), 0.5},
+		{rtmath.Point(1.25, 1.25, 3), 0.75},
+		{rtmath.Point(0, 0, -2), 1.0},
+	}
+	for _, c := range cases {
+		res, err := IntensityAt(w, l, c.point, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.FloatEqual(res, c.expected) {
+			t.Errorf("Expected intensity at %v to be %v but got %v", c.point, c.expected, res)
+		}
+	}
+}
+
+func TestAreaLightCastsPenumbra(t *testing.T) {
+	blocker := geometry.NewSphere()
+	blocker.Transform = rtmath.Translation(0, 2, 0)
+	l, err := shading.NewAreaLight(rtmath.Point(-1, 4, -1), rtmath.Vector(2, 0, 0), 4, rtmath.Vector(0, 0, 2), 4, canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorld()
+	w.Objects = []geometry.Shape{blocker}
+	w.Lights = []shading.Light{l}
+
+	// Points on the ground under the sphere, in its penumbra and well clear of it
+	umbra, err := IntensityAt(w, l, rtmath.Point(0, 0, 0), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	penumbra, err := IntensityAt(w, l, rtmath.Point(1.5, 0, 0), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lit, err := IntensityAt(w, l, rtmath.Point(10, 0, 0), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if umbra != 0 || penumbra <= 0 || penumbra >= 1 || lit != 1 {
+		t.Errorf("Expected intensity to rise from 0 to 1 through the penumbra but got %v, %v, %v", umbra, penumbra, lit)
+	}
+}
+
+func TestDirectionalLightShadowsReachInfinitely(t *testing.T) {
+	// Far above anything a point light could be placed
+	blocker := geometry.NewSphere()
+	blocker.Transform = rtmath.Translation(0, 1000, 0)
+	sun, err := shading.NewDirectionalLight(rtmath.Vector(0, -1, 0), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lamp, err := shading.NewPointLight(rtmath.Point(0, 10, 0), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorld()
+	w.Objects = []geometry.Shape{blocker}
+
+	type testCase struct {
+		light    shading.Light
+		point    rtmath.Tuple
+		shadowed bool
+	}
+	cases := []testCase{
+		{sun, rtmath.Point(0, 0, 0), true},
+		{sun, rtmath.Point(5, 0, 0), false},
+		{lamp, rtmath.Point(0, 0, 0), false},
+	}
+	for _, c := range cases {
+		res, err := IsShadowed(w, c.light, c.point, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != c.shadowed {
+			t.Errorf("Expected %v to be shadowed from %v: %v", c.point, c.light, c.shadowed)
+		}
+	}
+}
+
+func TestShadeHitWithSpotLight(t *testing.T) {
+	floor := geometry.NewPlane()
+	floor.Material.Specular = 0
+	spot, err := shading.NewSpotLight(rtmath.Point(0, 5, 0), rtmath.Vector(0, -1, 0), math.Pi/8, math.Pi/6, canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorld()
+	w.Objects = []geometry.Shape{floor}
+	w.Lights = []shading.Light{spot}
+
+	colorAbove := func(x float64) canvas.Color {
+		r, err := geometry.NewRay(rtmath.Point(x, 1, 0), rtmath.Vector(0, -1, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := ColorAt(w, r, w.MaxDepth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	// Inside the inner cone, in the falloff and outside the outer cone
+	inside, edge, outside := colorAbove(0), colorAbove(5*math.Tan(0.48)), colorAbove(4)
+	ambient := canvas.NewColor(0.1, 0.1, 0.1)
+	if !canvas.ColorEqual(inside, canvas.NewColor(1, 1, 1)) ||
+		edge.Red <= ambient.Red || edge.Red >= inside.Red ||
+		!canvas.ColorEqual(outside, ambient) {
+		t.Errorf("Expected the spot light to fade out toward its edge but got %v, %v, %v", inside, edge, outside)
+	}
+}
+
+func TestNoShadowRaysBeyondLightRange(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := w.Lights[0].(shading.PointLight)
+	l.Attenuation.Range = 20
+
+	type testCase struct {
+		point    rtmath.Tuple
+		expected float64
+	}
+	cases := []testCase{
+		// Lit and within range
+		{rtmath.Point(-2, 2, -2), 1},
+		// Lit but out of range
+		{rtmath.Point(10, 10, 10), 0},
+		// In shadow and within range
+		{rtmath.Point(0, 0, 1.0001), 0},
+	}
+	for _, c := range cases {
+		res, err := IntensityAt(w, l, c.point, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rtmath.FloatEqual(res, c.expected) {
+			t.Errorf("Expected intensity at %v to be %v but got %v", c.point, c.expected, res)
+		}
+	}
+}
+
+func TestOutOfRangeLightIsNotShadowed(t *testing.T) {
+	w, err := DefaultWorld()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := w.Lights[0].(shading.PointLight)
+	l.Attenuation.Range = 20
+
+	type testCase struct {
+		point    rtmath.Tuple
+		expected bool
+	}
+	cases := []testCase{
+		// Out of range with nothing in the way
+		{rtmath.Point(10, 10, 10), false},
+		// Within range behind the spheres
+		{rtmath.Point(0, 0, 1.0001), true},
+	}
+	for _, c := range cases {
+		res, err := IsShadowed(w, l, c.point, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != c.expected {
+			t.Errorf("Expected shadowed at %v to be %v but got %v", c.point, c.expected, res)
+		}
+	}
+}
+
+func TestMovingObjectCastsShadowAtTimeOfRay(t *testing.T) {
+	floor := geometry.NewPlane()
+	ball := geometry.NewSphere()
+	// Right above the origin by time 1
+	ball.Motion = geometry.LinearMotion(rtmath.Translation(5, 3, 0), rtmath.Translation(0, 3, 0))
+	l, err := shading.NewPointLight(rtmath.Point(0, 10, 0), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorld()
+	w.Objects = []geometry.Shape{floor, ball}
+	w.Lights = []shading.Light{l}
+
+	type testCase struct {
+		time     float64
+		expected canvas.Color
+	}
+	cases := []testCase{
+		// Lit from straight above, the eye is too far off for any highlight
+		{0, canvas.NewColor(1, 1, 1)},
+		{1, canvas.NewColor(0.1, 0.1, 0.1)},
+	}
+	for _, c := range cases {
+		r, err := geometry.NewRay(rtmath.Point(-5, 5, 0), rtmath.VectorNormalize(rtmath.Vector(1, -1, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Time = c.time
+		res, err := ColorAt(w, r, w.MaxDepth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canvas.ColorEqual(res, c.expected) {
+			t.Errorf("Expected %v at time %v to be %v", res, c.time, c.expected)
+		}
+	}
+}
+
+func TestPrepareComputationsReturnsNormalError(t *testing.T) {
+	shape := geometry.NewSphere()
+	shape.Motion = func(time float64) (rtmath.Matrix, error) {
+		return rtmath.Matrix{}, errors.New("no transform")
+	}
+	r, err := geometry.NewRay(rtmath.Point(0, 0, -5), rtmath.Vector(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := geometry.Intersection{Object: shape, T: 4}
+	_, err = PrepareComputations(i, r, geometry.Intersections(i))
+	if err == nil {
+		t.Errorf("Expected the error from the shape's motion")
+	}
+}
+
+func TestIsShadowedByMovingObjectAtTime(t *testing.T) {
+	ball := geometry.NewSphere()
+	ball.Motion = geometry.LinearMotion(rtmath.Translation(5, 3, 0), rtmath.Translation(0, 3, 0))
+	l, err := shading.NewPointLight(rtmath.Point(0, 10, 0), canvas.NewColor(1, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorld()
+	w.Objects = []geometry.Shape{ball}
+	w.Lights = []shading.Light{l}
+
+	type testCase struct {
+		time     float64
+		expected bool
+	}
+	cases := []testCase{
+		{0, false},
+		{1, true},
+	}
+	for _, c := range cases {
+		res, err := IsShadowed(w, l, rtmath.Point(0, 0, 0), c.time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != c.expected {
+			t.Errorf("Expected shadowed at time %v to be %v but got %v", c.time, c.expected, res)
+		}
+	}
+}